@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/atomyze-foundation/foundation/core/helpers"
 	pb "github.com/atomyze-foundation/foundation/proto"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/hyperledger/fabric-chaincode-go/shim"
@@ -39,7 +40,8 @@ func GetAccountAllRights(stub shim.ChaincodeStubInterface, params []string) (*pb
 	for _, param := range params {
 		args = append(args, []byte(param))
 	}
-	resp := stub.InvokeChaincode(CC, args, Ch)
+	ch, cc := helpers.ACLChaincode(stub)
+	resp := stub.InvokeChaincode(cc, args, ch)
 	if resp.Status != shim.OK {
 		return nil, errors.New(resp.Message)
 	}
@@ -70,7 +72,8 @@ func GetAccountRight(stub shim.ChaincodeStubInterface, params []string) (*pb.Hav
 	for _, param := range params {
 		args = append(args, []byte(param))
 	}
-	resp := stub.InvokeChaincode(CC, args, Ch)
+	ch, cc := helpers.ACLChaincode(stub)
+	resp := stub.InvokeChaincode(cc, args, ch)
 	if resp.Status != shim.OK {
 		return nil, errors.New(resp.Message)
 	}
@@ -98,7 +101,8 @@ func GetOperationAllRights(stub shim.ChaincodeStubInterface, params []string) (*
 	for _, param := range params {
 		args = append(args, []byte(param))
 	}
-	resp := stub.InvokeChaincode(CC, args, Ch)
+	ch, cc := helpers.ACLChaincode(stub)
+	resp := stub.InvokeChaincode(cc, args, ch)
 	if resp.Status != shim.OK {
 		return nil, errors.New(resp.Message)
 	}
@@ -1,10 +1,13 @@
 package acl
 
-// Ch - ACL channel name,
-// CC - ACL chaincode name
+import "github.com/atomyze-foundation/foundation/core/helpers"
+
+// Ch - default ACL channel name,
+// CC - default ACL chaincode name.
+// Actual names are taken from the ACL provider of the chaincode (see helpers.ACLChaincode)
 const (
-	Ch = "acl"
-	CC = "acl"
+	Ch = helpers.DefaultACLChannel
+	CC = helpers.DefaultACLChaincode
 )
 
 // acl chaincode functions
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/acl"
+	"github.com/atomyze-foundation/foundation/core/helpers"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/peer"
+)
+
+// aclCacheableFns is a list of ACL chaincode functions which don't change the ACL state,
+// so their responses can be reused until the end of the current invocation
+var aclCacheableFns = map[string]struct{}{
+	"checkKeys":           {},
+	"checkAddress":        {},
+	"getAccountInfo":      {},
+	acl.GetAccOpRightFn:   {},
+	acl.GetAccAllRightsFn: {},
+}
+
+// ACLCacheStats is a statistics of the ACL responses cache
+type ACLCacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// aclCacheStub caches responses of the ACL chaincode. It lives only during one invocation
+// (a single transaction or a whole batchExecute), so ACL changes made between invocations are always visible.
+// It also carries the ACL provider of the chaincode for helpers.ACL
+type aclCacheStub struct {
+	shim.ChaincodeStubInterface
+	provider  helpers.ACLProvider
+	responses map[string]peer.Response
+	stats     ACLCacheStats
+}
+
+func newACLCacheStub(stub shim.ChaincodeStubInterface, provider helpers.ACLProvider) *aclCacheStub {
+	return &aclCacheStub{
+		ChaincodeStubInterface: stub,
+		provider:               provider,
+		responses:              make(map[string]peer.Response),
+	}
+}
+
+// ACLProvider returns the ACL provider of the chaincode
+func (s *aclCacheStub) ACLProvider() helpers.ACLProvider {
+	return s.provider
+}
+
+// InvokeChaincode returns cached response for read-only ACL requests
+// or calls chaincode if response is absent or request can't be cached
+func (s *aclCacheStub) InvokeChaincode(chaincodeName string, args [][]byte, channel string) peer.Response {
+	aclChannel, aclChaincode := helpers.ACLChaincode(s)
+	if chaincodeName != aclChaincode || channel != aclChannel || !isACLCacheable(args) {
+		return s.ChaincodeStubInterface.InvokeChaincode(chaincodeName, args, channel)
+	}
+
+	key := aclCacheKey(chaincodeName, args, channel)
+	if resp, ok := s.responses[key]; ok {
+		s.stats.Hits++
+		return resp
+	}
+
+	s.stats.Misses++
+	resp := s.ChaincodeStubInterface.InvokeChaincode(chaincodeName, args, channel)
+	if resp.Status == shim.OK {
+		s.responses[key] = resp
+	}
+	return resp
+}
+
+// Stats returns hits and misses of the ACL cache
+func (s *aclCacheStub) Stats() ACLCacheStats {
+	return s.stats
+}
+
+func isACLCacheable(args [][]byte) bool {
+	if len(args) == 0 {
+		return false
+	}
+	_, ok := aclCacheableFns[string(args[0])]
+	return ok
+}
+
+func aclCacheKey(chaincodeName string, args [][]byte, channel string) string {
+	parts := make([]string, 0, len(args)+2) //nolint:gomnd
+	parts = append(parts, channel, chaincodeName)
+	for _, arg := range args {
+		parts = append(parts, string(arg))
+	}
+	return strings.Join(parts, "\x00")
+}
@@ -0,0 +1,201 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/atomyze-foundation/foundation/core/acl"
+	"github.com/atomyze-foundation/foundation/core/helpers"
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/mock/stub"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/stretchr/testify/assert"
+	pb "google.golang.org/protobuf/proto"
+)
+
+type invokeCountingStub struct {
+	*mockStub
+	invokes int
+	status  int32
+}
+
+func (stub *invokeCountingStub) InvokeChaincode(_ string, args [][]byte, _ string) peer.Response {
+	stub.invokes++
+	return peer.Response{Status: stub.status, Payload: args[len(args)-1]}
+}
+
+func TestACLCacheStub(t *testing.T) {
+	counting := &invokeCountingStub{mockStub: newMockStub(), status: shim.OK}
+	cacheStub := newACLCacheStub(counting, nil)
+
+	for i := 0; i < 3; i++ {
+		resp := cacheStub.InvokeChaincode(acl.CC, [][]byte{[]byte("checkKeys"), []byte("key1")}, acl.Ch)
+		assert.Equal(t, "key1", string(resp.Payload))
+		resp = cacheStub.InvokeChaincode(acl.CC, [][]byte{[]byte("checkAddress"), []byte("addr1")}, acl.Ch)
+		assert.Equal(t, "addr1", string(resp.Payload))
+	}
+	resp := cacheStub.InvokeChaincode(acl.CC, [][]byte{[]byte("checkKeys"), []byte("key2")}, acl.Ch)
+	assert.Equal(t, "key2", string(resp.Payload))
+
+	assert.Equal(t, 3, counting.invokes)
+	assert.Equal(t, ACLCacheStats{Hits: 4, Misses: 3}, cacheStub.Stats())
+}
+
+func TestACLCacheStubSkipsNotCacheable(t *testing.T) {
+	counting := &invokeCountingStub{mockStub: newMockStub(), status: shim.OK}
+	cacheStub := newACLCacheStub(counting, nil)
+
+	for i := 0; i < 2; i++ {
+		_ = cacheStub.InvokeChaincode(acl.CC, [][]byte{[]byte(acl.AddRightsFn), []byte("addr1")}, acl.Ch)
+		_ = cacheStub.InvokeChaincode("other", [][]byte{[]byte("checkKeys"), []byte("key1")}, "other")
+	}
+	assert.Equal(t, 4, counting.invokes)
+	assert.Equal(t, ACLCacheStats{}, cacheStub.Stats())
+}
+
+func TestACLCacheStubSkipsErrors(t *testing.T) {
+	counting := &invokeCountingStub{mockStub: newMockStub(), status: shim.ERROR}
+	cacheStub := newACLCacheStub(counting, nil)
+
+	for i := 0; i < 2; i++ {
+		resp := cacheStub.InvokeChaincode(acl.CC, [][]byte{[]byte("checkKeys"), []byte("key1")}, acl.Ch)
+		assert.Equal(t, int32(shim.ERROR), resp.Status)
+	}
+	assert.Equal(t, 2, counting.invokes)
+	assert.Equal(t, ACLCacheStats{Misses: 2}, cacheStub.Stats())
+}
+
+func TestACLCacheIsScopedToInvocation(t *testing.T) {
+	counting := &invokeCountingStub{mockStub: newMockStub(), status: shim.OK}
+
+	// every invocation gets its own cache, so the ACL is requested again
+	for i := 0; i < 2; i++ {
+		cacheStub := newACLCacheStub(counting, nil)
+		_ = cacheStub.InvokeChaincode(acl.CC, [][]byte{[]byte("getAccountInfo"), []byte("addr1")}, acl.Ch)
+		_ = cacheStub.InvokeChaincode(acl.CC, [][]byte{[]byte("getAccountInfo"), []byte("addr1")}, acl.Ch)
+	}
+	assert.Equal(t, 2, counting.invokes)
+}
+
+func TestACLCacheStubCustomACLChaincode(t *testing.T) {
+	counting := &invokeCountingStub{mockStub: newMockStub(), status: shim.OK}
+	cacheStub := newACLCacheStub(counting, helpers.NewChaincodeACLProvider("aclch", "aclcc"))
+
+	for i := 0; i < 2; i++ {
+		_ = cacheStub.InvokeChaincode("aclcc", [][]byte{[]byte("checkKeys"), []byte("key1")}, "aclch")
+		_ = cacheStub.InvokeChaincode(acl.CC, [][]byte{[]byte("checkKeys"), []byte("key1")}, acl.Ch)
+	}
+	assert.Equal(t, 3, counting.invokes)
+	assert.Equal(t, ACLCacheStats{Hits: 1, Misses: 1}, cacheStub.Stats())
+}
+
+// aclBenchContract resolves the sender and the recipient through the ACL like transfers do
+type aclBenchContract struct {
+	BaseContract
+}
+
+func (*aclBenchContract) GetID() string {
+	return "BENCH"
+}
+
+func (bc *aclBenchContract) TxResolve(sender *types.Sender, to *types.Address) error {
+	for _, addr := range []*types.Address{sender.Address(), to} {
+		if _, err := helpers.GetFullAddress(bc.GetStub(), addr.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aclBenchStub answers requests to the ACL chaincode and counts them
+type aclBenchStub struct {
+	*stub.Stub
+	invokes int
+}
+
+func (s *aclBenchStub) InvokeChaincode(_ string, args [][]byte, _ string) peer.Response {
+	s.invokes++
+	if string(args[0]) == "getAccountInfo" {
+		return shim.Success([]byte("{}"))
+	}
+	addr, err := types.AddrFromBase58Check(string(args[1]))
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	payload, err := pb.Marshal(&proto.Address{UserID: addr.String(), Address: addr.Address})
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(payload)
+}
+
+// BenchmarkBatchExecuteACLCache executes batches of transactions between a few senders and recipients
+// with and without the ACL cache and reports requests to the ACL chaincode
+func BenchmarkBatchExecuteACLCache(b *testing.B) {
+	const (
+		batchSize = 100
+		addresses = 4
+	)
+
+	wallets := make([]*types.Address, addresses)
+	for i := range wallets {
+		wallets[i] = &types.Address{Address: bytes.Repeat([]byte{byte(i + 1)}, 32)} //nolint:gomnd
+	}
+
+	for _, cached := range []bool{false, true} {
+		b.Run(fmt.Sprintf("cache=%t", cached), func(b *testing.B) {
+			chainCode, err := NewCC(&aclBenchContract{}, nil)
+			assert.NoError(b, err)
+
+			var invokes, hits, misses uint64
+			nonce := uint64(time.Now().UnixMilli())
+			for n := 0; n < b.N; n++ {
+				b.StopTimer()
+				benchStub := &aclBenchStub{Stub: stub.NewMockStub(testChaincodeName, chainCode)}
+				batch := &proto.Batch{}
+				for i := 0; i < batchSize; i++ {
+					txID := fmt.Sprintf("%x", fmt.Sprintf("tx%d", i))
+					benchStub.TxID = txID
+					benchStub.MockTransactionStart(txID)
+					from := wallets[i%addresses]
+					to := wallets[(i+1)%addresses]
+					nonce++
+					err = chainCode.saveToBatch(benchStub, "resolve", &proto.Address{Address: from.Address}, []string{to.String()}, nonce)
+					assert.NoError(b, err)
+					benchStub.MockTransactionEnd(txID)
+					batch.TxIDs = append(batch.TxIDs, []byte(fmt.Sprintf("tx%d", i)))
+				}
+				dataIn, err := pb.Marshal(batch)
+				assert.NoError(b, err)
+
+				benchStub.TxID = "batch"
+				benchStub.MockTransactionStart("batch")
+				benchStub.invokes = 0
+				var execStub shim.ChaincodeStubInterface = benchStub
+				cacheStub := newACLCacheStub(benchStub, nil)
+				if cached {
+					execStub = cacheStub
+				}
+				b.StartTimer()
+
+				resp := chainCode.batchExecute(execStub, string(dataIn), nil, nil)
+
+				b.StopTimer()
+				assert.Equal(b, int32(shim.OK), resp.Status)
+				benchStub.MockTransactionEnd("batch")
+				invokes += uint64(benchStub.invokes)
+				hits += cacheStub.Stats().Hits
+				misses += cacheStub.Stats().Misses
+				b.StartTimer()
+			}
+
+			b.ReportMetric(float64(invokes)/float64(b.N), "invokes/op")
+			b.ReportMetric(float64(hits)/float64(b.N), "hits/op")
+			b.ReportMetric(float64(misses)/float64(b.N), "misses/op")
+		})
+	}
+}
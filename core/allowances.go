@@ -0,0 +1,52 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+)
+
+// ErrInsufficientAllowance is returned when the spender tries to use more tokens than the owner allowed
+var ErrInsufficientAllowance = errors.New("insufficient allowance")
+
+// TokenAllowanceGet returns the amount of tokens the spender is allowed to transfer from the owner's token balance
+func (bc *BaseContract) TokenAllowanceGet(owner *types.Address, spender *types.Address) (*big.Int, error) {
+	_, allowance, err := balanceGet(bc.stub, StateKeyTokenAllowance, owner, spender.String())
+	return allowance, err
+}
+
+// TokenAllowanceSet replaces the allowance of the spender, zero amount deletes the allowance
+func (bc *BaseContract) TokenAllowanceSet(owner *types.Address, spender *types.Address, amount *big.Int) error {
+	if amount.Sign() < 0 {
+		return errors.New("amount should be positive")
+	}
+	key, _, err := balanceGet(bc.stub, StateKeyTokenAllowance, owner, spender.String())
+	if err != nil {
+		return err
+	}
+	if amount.Sign() == 0 {
+		return bc.stub.DelState(key)
+	}
+	return bc.stub.PutState(key, amount.Bytes())
+}
+
+// TokenAllowanceAdd increases the allowance of the spender
+func (bc *BaseContract) TokenAllowanceAdd(owner *types.Address, spender *types.Address, amount *big.Int) error {
+	return balanceAdd(bc.stub, StateKeyTokenAllowance, owner, amount, spender.String())
+}
+
+// TokenAllowanceSub decreases the allowance of the spender
+func (bc *BaseContract) TokenAllowanceSub(owner *types.Address, spender *types.Address, amount *big.Int) error {
+	if amount.Sign() < 0 {
+		return errors.New("amount should be positive")
+	}
+	allowance, err := bc.TokenAllowanceGet(owner, spender)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(amount) < 0 {
+		return ErrInsufficientAllowance
+	}
+	return bc.TokenAllowanceSet(owner, spender, new(big.Int).Sub(allowance, amount))
+}
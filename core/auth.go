@@ -116,14 +116,11 @@ func (cc *ChainCode) checkAuthIfNeeds( //nolint:gocognit,funlen
 	if err != nil {
 		return nil, nil, 0, err
 	}
-	N := 1 // for single sign
-	if signers > 1 {
-		if acl.Address != nil && acl.Address.SignaturePolicy != nil {
-			N = int(acl.Address.SignaturePolicy.N)
-		} else {
-			N = signers // If it's not in the acl, everyone has to sign
-		}
+	var policy *pb.SignaturePolicy
+	if acl.Address != nil {
+		policy = acl.Address.SignaturePolicy
 	}
+	signPolicy := newSignaturePolicy(policy, signers)
 
 	for i := authPos; i < authPos+signers; i++ {
 		if args[i+signers] == "" {
@@ -135,11 +132,11 @@ func (cc *ChainCode) checkAuthIfNeeds( //nolint:gocognit,funlen
 			return nil, nil, 0, errors.New("incorrect signature")
 		}
 
-		N--
+		signPolicy.sign(key)
 	}
 
-	if N > 0 {
-		return nil, nil, 0, errors.New("signature policy isn't satisfied")
+	if err = signPolicy.check(); err != nil {
+		return nil, nil, 0, err
 	}
 
 	if acl.Account != nil && acl.Account.BlackListed {
@@ -0,0 +1,245 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/atomyze-foundation/foundation/core/helpers"
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/peer"
+)
+
+// BalanceChangesEvent is the name of the event with pb.BalanceChanges of the transaction
+const BalanceChangesEvent = "BalanceChanges"
+
+// reasons of balance changes made by helpers which don't take a reason
+const (
+	balanceLockReason   = "lock"
+	balanceUnlockReason = "unlock"
+	// given balances change when tokens go to or come back from another channel
+	givenBalanceAddReason = "given"
+	givenBalanceSubReason = "given back"
+)
+
+var balanceKindStateKeys = map[pb.BalanceChange_Kind]StateKey{
+	pb.BalanceChange_TOKEN:             StateKeyTokenBalance,
+	pb.BalanceChange_INDUSTRIAL:        StateKeyTokenBalance,
+	pb.BalanceChange_ALLOWED:           StateKeyAllowedBalance,
+	pb.BalanceChange_LOCKED_TOKEN:      StateKeyLockedTokenBalance,
+	pb.BalanceChange_LOCKED_INDUSTRIAL: StateKeyLockedTokenBalance,
+	pb.BalanceChange_LOCKED_ALLOWED:    StateKeyLockedAllowedBalance,
+}
+
+// balanceChangeCollector is implemented by stubs which deliver balance changes as an event
+type balanceChangeCollector interface {
+	addBalanceChange(change *pb.BalanceChange) error
+}
+
+// balanceChange describes a balance mutation before the resulting balances are read
+type balanceChange struct {
+	token    string
+	fromKind pb.BalanceChange_Kind
+	toKind   pb.BalanceChange_Kind
+	from     *types.Address
+	to       *types.Address
+	amount   *big.Int
+	reason   string
+	// path is a path of the balance composite key after the address (industrial group or allowed token)
+	path []string
+}
+
+// emitBalanceChange reads resulting balances of the change, writes it to the journal if it's enabled
+// and passes it to the stub if the stub collects balance changes (query stubs don't)
+func (bc *BaseContract) emitBalanceChange(c balanceChange) error {
+	collector, ok := bc.stub.(balanceChangeCollector)
+	if !ok {
+		return nil
+	}
+
+	change := &pb.BalanceChange{
+		Token:    c.token,
+		FromKind: c.fromKind,
+		ToKind:   c.toKind,
+		Amount:   c.amount.Bytes(),
+		Reason:   c.reason,
+	}
+	if !isEmptyAddress(c.from) {
+		_, balance, err := balanceGet(bc.stub, balanceKindStateKeys[c.fromKind], c.from, c.path...)
+		if err != nil {
+			return err
+		}
+		change.From = c.from.Bytes()
+		change.FromBalance = balance.Bytes()
+	}
+	if !isEmptyAddress(c.to) {
+		_, balance, err := balanceGet(bc.stub, balanceKindStateKeys[c.toKind], c.to, c.path...)
+		if err != nil {
+			return err
+		}
+		change.To = c.to.Bytes()
+		change.ToBalance = balance.Bytes()
+	}
+
+	if bc.journal.enabled {
+		if err := bc.writeJournal(change); err != nil {
+			return err
+		}
+	}
+	return collector.addBalanceChange(change)
+}
+
+func (bc *BaseContract) tokenChange(fromKind, toKind pb.BalanceChange_Kind, from, to *types.Address, amount *big.Int, reason string) balanceChange {
+	return balanceChange{token: bc.id, fromKind: fromKind, toKind: toKind, from: from, to: to, amount: amount, reason: reason}
+}
+
+func (bc *BaseContract) industrialChange(group string, fromKind, toKind pb.BalanceChange_Kind, from, to *types.Address, amount *big.Int, reason string) balanceChange {
+	return balanceChange{
+		token: bc.id + "_" + group, fromKind: fromKind, toKind: toKind,
+		from: from, to: to, amount: amount, reason: reason, path: []string{group},
+	}
+}
+
+func allowedChange(token string, fromKind, toKind pb.BalanceChange_Kind, from, to *types.Address, amount *big.Int, reason string) balanceChange {
+	return balanceChange{
+		token: token, fromKind: fromKind, toKind: toKind,
+		from: from, to: to, amount: amount, reason: reason, path: []string{token},
+	}
+}
+
+func isEmptyAddress(addr *types.Address) bool {
+	return addr == nil || len(addr.Address) == 0
+}
+
+// addBalanceChange adds balance change to the BalanceChanges event of the batched transaction
+func (bts *BatchTxStub) addBalanceChange(change *pb.BalanceChange) error {
+	bts.balanceChanges = append(bts.balanceChanges, change)
+	data, err := proto.Marshal(&pb.BalanceChanges{Changes: bts.balanceChanges})
+	if err != nil {
+		return err
+	}
+	bts.events[BalanceChangesEvent] = data
+	return nil
+}
+
+// balanceEventStub collects balance changes of a transaction executed without batch
+// and sets them as the BalanceChanges event when the transaction succeeds.
+// Fabric keeps only one event per transaction, so the event set by the method (e.g. the "key"
+// event of swapDone) is carried inside the BalanceChanges event if the transaction changed balances.
+// Fabric doesn't read writes of the transaction, so the stub keeps them to return resulting balances
+// and journal sequences instead of the state before the transaction
+type balanceEventStub struct {
+	shim.ChaincodeStubInterface
+	writes       map[string]*pb.WriteElement
+	changes      []*pb.BalanceChange
+	eventSet     bool
+	eventName    string
+	eventPayload []byte
+}
+
+func newBalanceEventStub(stub shim.ChaincodeStubInterface) *balanceEventStub {
+	return &balanceEventStub{
+		ChaincodeStubInterface: stub,
+		writes:                 make(map[string]*pb.WriteElement),
+	}
+}
+
+// GetState returns the state written by the transaction or, if absent, the chaincode state
+func (s *balanceEventStub) GetState(key string) ([]byte, error) {
+	if element, ok := s.writes[key]; ok {
+		return element.Value, nil
+	}
+	return s.ChaincodeStubInterface.GetState(key)
+}
+
+// PutState puts state to the chaincode state and keeps it for reads of the transaction
+func (s *balanceEventStub) PutState(key string, value []byte) error {
+	if err := s.ChaincodeStubInterface.PutState(key, value); err != nil {
+		return err
+	}
+	s.writes[key] = &pb.WriteElement{Key: key, Value: value}
+	return nil
+}
+
+// DelState deletes state from the chaincode state and keeps the deletion for reads of the transaction
+func (s *balanceEventStub) DelState(key string) error {
+	if err := s.ChaincodeStubInterface.DelState(key); err != nil {
+		return err
+	}
+	s.writes[key] = &pb.WriteElement{Key: key, IsDeleted: true}
+	return nil
+}
+
+// ACLProvider returns the ACL provider of the wrapped stub
+func (s *balanceEventStub) ACLProvider() helpers.ACLProvider {
+	return helpers.ACL(s.ChaincodeStubInterface)
+}
+
+// SetEvent keeps the event of the method until the transaction is over
+func (s *balanceEventStub) SetEvent(name string, payload []byte) error {
+	if name == "" {
+		return errors.New("event name can not be empty string")
+	}
+	s.eventSet = true
+	s.eventName = name
+	s.eventPayload = payload
+	return nil
+}
+
+func (s *balanceEventStub) addBalanceChange(change *pb.BalanceChange) error {
+	s.changes = append(s.changes, change)
+	return nil
+}
+
+// flush sets the BalanceChanges event with the event of the method if the response is successful,
+// the event of the method is set as is if balances weren't changed
+func (s *balanceEventStub) flush(resp peer.Response) peer.Response {
+	if resp.Status != shim.OK {
+		return resp
+	}
+	if len(s.changes) == 0 {
+		if !s.eventSet {
+			return resp
+		}
+		if err := s.ChaincodeStubInterface.SetEvent(s.eventName, s.eventPayload); err != nil {
+			return shim.Error(err.Error())
+		}
+		return resp
+	}
+	data, err := proto.Marshal(&pb.BalanceChanges{
+		Changes:      s.changes,
+		EventName:    s.eventName,
+		EventPayload: s.eventPayload,
+	})
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err = s.ChaincodeStubInterface.SetEvent(BalanceChangesEvent, data); err != nil {
+		return shim.Error(err.Error())
+	}
+	return resp
+}
+
+// emitGivenBalanceChange passes the change of the given balance of the contract to the stub
+// if the stub collects balance changes, the given balance doesn't belong to an address
+func emitGivenBalanceChange(stub shim.ChaincodeStubInterface, contract string, credit bool, amount *big.Int, balance *big.Int, reason string) error {
+	collector, ok := stub.(balanceChangeCollector)
+	if !ok {
+		return nil
+	}
+	change := &pb.BalanceChange{
+		Token:    contract,
+		FromKind: pb.BalanceChange_GIVEN,
+		ToKind:   pb.BalanceChange_GIVEN,
+		Amount:   amount.Bytes(),
+		Reason:   reason,
+	}
+	if credit {
+		change.ToBalance = balance.Bytes()
+	} else {
+		change.FromBalance = balance.Bytes()
+	}
+	return collector.addBalanceChange(change)
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric-chaincode-go/shimtest" //nolint:staticcheck
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// peerStub doesn't read writes of the transaction like the Fabric peer,
+// they are applied to the state only when the transaction is committed
+type peerStub struct {
+	*shimtest.MockStub
+	writes map[string][]byte
+}
+
+func newPeerStub() *peerStub {
+	stub := shimtest.NewMockStub("cc", nil)
+	stub.TxID = "0a"
+	stub.TxTimestamp = &timestamp.Timestamp{Seconds: 1}
+	return &peerStub{MockStub: stub, writes: make(map[string][]byte)}
+}
+
+func (stub *peerStub) PutState(key string, value []byte) error {
+	stub.writes[key] = value
+	return nil
+}
+
+func (stub *peerStub) DelState(key string) error {
+	stub.writes[key] = nil
+	return nil
+}
+
+func newEventContract(stub *peerStub, journal bool) (*BaseContract, *balanceEventStub) {
+	eventStub := newBalanceEventStub(stub)
+	bc := &BaseContract{id: "CC"}
+	bc.setStubAndInitArgs(eventStub, nil, nil, contractSettings{journal: journalSettings{enabled: journal}})
+	return bc, eventStub
+}
+
+func TestBalanceEventsReadWritesOfTransaction(t *testing.T) {
+	bc, eventStub := newEventContract(newPeerStub(), false)
+	addr := &types.Address{Address: make([]byte, 32)}
+	addr.Address[0] = 1
+
+	require.NoError(t, bc.tokenBalanceAdd(addr, big.NewInt(10), "CC", "emit"))
+	require.NoError(t, bc.tokenBalanceAdd(addr, big.NewInt(5), "CC", "emit"))
+	require.NoError(t, bc.TokenBalanceLock(addr, big.NewInt(3)))
+
+	require.Len(t, eventStub.changes, 3)
+	assert.Equal(t, big.NewInt(10).Bytes(), eventStub.changes[0].ToBalance)
+	assert.Equal(t, big.NewInt(15).Bytes(), eventStub.changes[1].ToBalance)
+	assert.Equal(t, pb.BalanceChange_LOCKED_TOKEN, eventStub.changes[2].ToKind)
+	assert.Equal(t, big.NewInt(12).Bytes(), eventStub.changes[2].FromBalance)
+	assert.Equal(t, big.NewInt(3).Bytes(), eventStub.changes[2].ToBalance)
+
+	balance, err := bc.TokenBalanceGet(addr)
+	require.NoError(t, err)
+	assert.Equal(t, "12", balance.String())
+}
@@ -26,6 +26,8 @@ const (
 	StateKeyPassedNonce // This prefix is used for nones at the US
 	StateKeyExternalLockedToken
 	StateKeyExternalLockedAllowed
+	StateKeyTokenAllowance // [owner, spender] amount of tokens the spender can transfer from the owner
+	StateKeyExternalLockedIndustrial
 )
 
 func balanceGet(stub shim.ChaincodeStubInterface, tokenType StateKey, addr *types.Address, path ...string) (string, *big.Int, error) {
@@ -52,6 +54,9 @@ func balanceSub(stub shim.ChaincodeStubInterface, tokenType StateKey, addr *type
 	if balance.Cmp(amount) < 0 {
 		return errors.New("insufficient funds to process")
 	}
+	if err = snapshotBalance(stub, tokenType, addr, path, balance); err != nil {
+		return err
+	}
 	return stub.PutState(key, new(big.Int).Sub(balance, amount).Bytes())
 }
 
@@ -63,6 +68,9 @@ func balanceAdd(stub shim.ChaincodeStubInterface, tokenType StateKey, addr *type
 	if err != nil {
 		return err
 	}
+	if err = snapshotBalance(stub, tokenType, addr, path, balance); err != nil {
+		return err
+	}
 	return stub.PutState(key, new(big.Int).Add(balance, amount).Bytes())
 }
 
@@ -73,20 +81,40 @@ func balanceTransfer(stub shim.ChaincodeStubInterface, tokenType StateKey, from
 	return balanceAdd(stub, tokenType, to, amount, path...)
 }
 
-func (bc *BaseContract) tokenBalanceSub(address *types.Address, amount *big.Int, token string) error {
+func (bc *BaseContract) tokenBalanceSub(address *types.Address, amount *big.Int, token string, reason string) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	parts := strings.Split(token, "_")
 	if len(parts) > 1 {
-		return balanceSub(bc.stub, StateKeyTokenBalance, address, amount, parts[len(parts)-1])
+		group := parts[len(parts)-1]
+		if err := balanceSub(bc.stub, StateKeyTokenBalance, address, amount, group); err != nil {
+			return err
+		}
+		return bc.emitBalanceChange(bc.industrialChange(group, pb.BalanceChange_INDUSTRIAL, pb.BalanceChange_INDUSTRIAL, address, nil, amount, reason))
 	}
-	return balanceSub(bc.stub, StateKeyTokenBalance, address, amount)
+	if err := balanceSub(bc.stub, StateKeyTokenBalance, address, amount); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_TOKEN, pb.BalanceChange_TOKEN, address, nil, amount, reason))
 }
 
-func (bc *BaseContract) tokenBalanceAdd(address *types.Address, amount *big.Int, token string) error {
+func (bc *BaseContract) tokenBalanceAdd(address *types.Address, amount *big.Int, token string, reason string) error {
+	if err := checkFrozen(bc.stub, nil, address); err != nil {
+		return err
+	}
 	parts := strings.Split(token, "_")
 	if len(parts) > 1 {
-		return balanceAdd(bc.stub, StateKeyTokenBalance, address, amount, parts[len(parts)-1])
+		group := parts[len(parts)-1]
+		if err := balanceAdd(bc.stub, StateKeyTokenBalance, address, amount, group); err != nil {
+			return err
+		}
+		return bc.emitBalanceChange(bc.industrialChange(group, pb.BalanceChange_INDUSTRIAL, pb.BalanceChange_INDUSTRIAL, nil, address, amount, reason))
+	}
+	if err := balanceAdd(bc.stub, StateKeyTokenBalance, address, amount); err != nil {
+		return err
 	}
-	return balanceAdd(bc.stub, StateKeyTokenBalance, address, amount)
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_TOKEN, pb.BalanceChange_TOKEN, nil, address, amount, reason))
 }
 
 func balanceList(stub shim.ChaincodeStubInterface, tokenType StateKey, address *types.Address) (map[string]string, error) {
@@ -125,48 +153,78 @@ func (bc *BaseContract) IndustrialBalanceGet(address *types.Address) (map[string
 
 // IndustrialBalanceTransfer transfers industrial balance from one address to another
 func (bc *BaseContract) IndustrialBalanceTransfer(token string, from *types.Address, to *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, from, to); err != nil {
+		return err
+	}
 	parts := strings.Split(token, "_")
 	token = parts[len(parts)-1]
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id+"_"+token, from, to, amount, reason)
 	}
-	return balanceTransfer(bc.stub, StateKeyTokenBalance, from, to, amount, token)
+	if err := balanceTransfer(bc.stub, StateKeyTokenBalance, from, to, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.industrialChange(token, pb.BalanceChange_INDUSTRIAL, pb.BalanceChange_INDUSTRIAL, from, to, amount, reason))
 }
 
 // IndustrialBalanceAdd adds industrial balance to given address
 func (bc *BaseContract) IndustrialBalanceAdd(token string, address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, nil, address); err != nil {
+		return err
+	}
 	parts := strings.Split(token, "_")
 	token = parts[len(parts)-1]
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id+"_"+token, &types.Address{}, address, amount, reason)
 	}
-	return balanceAdd(bc.stub, StateKeyTokenBalance, address, amount, token)
+	if err := balanceAdd(bc.stub, StateKeyTokenBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.industrialChange(token, pb.BalanceChange_INDUSTRIAL, pb.BalanceChange_INDUSTRIAL, nil, address, amount, reason))
 }
 
 // IndustrialBalanceSub subtracts industrial balance from given address
 func (bc *BaseContract) IndustrialBalanceSub(token string, address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	parts := strings.Split(token, "_")
 	token = parts[len(parts)-1]
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id+"_"+token, address, &types.Address{}, amount, reason)
 	}
-	return balanceSub(bc.stub, StateKeyTokenBalance, address, amount, token)
+	if err := balanceSub(bc.stub, StateKeyTokenBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.industrialChange(token, pb.BalanceChange_INDUSTRIAL, pb.BalanceChange_INDUSTRIAL, address, nil, amount, reason))
 }
 
 // TokenBalanceTransfer transfers token balance from one address to another
 func (bc *BaseContract) TokenBalanceTransfer(from *types.Address, to *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, from, to); err != nil {
+		return err
+	}
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id, from, to, amount, reason)
 	}
-	return balanceTransfer(bc.stub, StateKeyTokenBalance, from, to, amount)
+	if err := balanceTransfer(bc.stub, StateKeyTokenBalance, from, to, amount); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_TOKEN, pb.BalanceChange_TOKEN, from, to, amount, reason))
 }
 
 // AllowedBalanceTransfer transfers allowed balance from one address to another
 func (bc *BaseContract) AllowedBalanceTransfer(token string, from *types.Address, to *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, from, to); err != nil {
+		return err
+	}
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(token, from, to, amount, reason)
 	}
-	return balanceTransfer(bc.stub, StateKeyAllowedBalance, from, to, amount, token)
+	if err := balanceTransfer(bc.stub, StateKeyAllowedBalance, from, to, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(allowedChange(token, pb.BalanceChange_ALLOWED, pb.BalanceChange_ALLOWED, from, to, amount, reason))
 }
 
 // TokenBalanceGet returns token balance for given address
@@ -177,18 +235,30 @@ func (bc *BaseContract) TokenBalanceGet(address *types.Address) (*big.Int, error
 
 // TokenBalanceAdd adds token balance to given address
 func (bc *BaseContract) TokenBalanceAdd(address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, nil, address); err != nil {
+		return err
+	}
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id, &types.Address{}, address, amount, reason)
 	}
-	return balanceAdd(bc.stub, StateKeyTokenBalance, address, amount)
+	if err := balanceAdd(bc.stub, StateKeyTokenBalance, address, amount); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_TOKEN, pb.BalanceChange_TOKEN, nil, address, amount, reason))
 }
 
 // TokenBalanceSub subtracts token balance from given address
 func (bc *BaseContract) TokenBalanceSub(address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id, address, &types.Address{}, amount, reason)
 	}
-	return balanceSub(bc.stub, StateKeyTokenBalance, address, amount)
+	if err := balanceSub(bc.stub, StateKeyTokenBalance, address, amount); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_TOKEN, pb.BalanceChange_TOKEN, address, nil, amount, reason))
 }
 
 // TokenBalanceGetLocked returns locked token balance for given address
@@ -199,37 +269,66 @@ func (bc *BaseContract) TokenBalanceGetLocked(address *types.Address) (*big.Int,
 
 // TokenBalanceLock locks token balance for given address
 func (bc *BaseContract) TokenBalanceLock(address *types.Address, amount *big.Int) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	if err := balanceSub(bc.stub, StateKeyTokenBalance, address, amount); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyLockedTokenBalance, address, amount)
+	if err := balanceAdd(bc.stub, StateKeyLockedTokenBalance, address, amount); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_TOKEN, pb.BalanceChange_LOCKED_TOKEN, address, address, amount, balanceLockReason))
 }
 
 // TokenBalanceUnlock unlocks token balance for given address
 func (bc *BaseContract) TokenBalanceUnlock(address *types.Address, amount *big.Int) error {
+	if err := checkFrozen(bc.stub, nil, address); err != nil {
+		return err
+	}
 	if err := balanceSub(bc.stub, StateKeyLockedTokenBalance, address, amount); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyTokenBalance, address, amount)
+	if err := balanceAdd(bc.stub, StateKeyTokenBalance, address, amount); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_LOCKED_TOKEN, pb.BalanceChange_TOKEN, address, address, amount, balanceUnlockReason))
 }
 
 // TokenBalanceTransferLocked transfers locked token balance from one address to another
 func (bc *BaseContract) TokenBalanceTransferLocked(from *types.Address, to *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, from, to); err != nil {
+		return err
+	}
+	return bc.tokenBalanceTransferLocked(from, to, amount, reason)
+}
+
+// tokenBalanceTransferLocked transfers locked token balance without checks of frozen addresses
+func (bc *BaseContract) tokenBalanceTransferLocked(from *types.Address, to *types.Address, amount *big.Int, reason string) error {
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id, from, to, amount, reason)
 	}
 	if err := balanceSub(bc.stub, StateKeyLockedTokenBalance, from, amount); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyTokenBalance, to, amount)
+	if err := balanceAdd(bc.stub, StateKeyTokenBalance, to, amount); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_LOCKED_TOKEN, pb.BalanceChange_TOKEN, from, to, amount, reason))
 }
 
 // TokenBalanceBurnLocked burns locked token balance for given address
 func (bc *BaseContract) TokenBalanceBurnLocked(address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id, address, &types.Address{}, amount, reason)
 	}
-	return balanceSub(bc.stub, StateKeyLockedTokenBalance, address, amount)
+	if err := balanceSub(bc.stub, StateKeyLockedTokenBalance, address, amount); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.tokenChange(pb.BalanceChange_LOCKED_TOKEN, pb.BalanceChange_LOCKED_TOKEN, address, nil, amount, reason))
 }
 
 // AllowedBalanceGet returns allowed balance for given address
@@ -240,22 +339,37 @@ func (bc *BaseContract) AllowedBalanceGet(token string, address *types.Address)
 
 // AllowedBalanceAdd adds allowed balance to given address
 func (bc *BaseContract) AllowedBalanceAdd(token string, address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, nil, address); err != nil {
+		return err
+	}
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(token, &types.Address{}, address, amount, reason)
 	}
-	return balanceAdd(bc.stub, StateKeyAllowedBalance, address, amount, token)
+	if err := balanceAdd(bc.stub, StateKeyAllowedBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(allowedChange(token, pb.BalanceChange_ALLOWED, pb.BalanceChange_ALLOWED, nil, address, amount, reason))
 }
 
 // AllowedBalanceSub subtracts allowed balance from given address
 func (bc *BaseContract) AllowedBalanceSub(token string, address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(token, address, &types.Address{}, amount, reason)
 	}
-	return balanceSub(bc.stub, StateKeyAllowedBalance, address, amount, token)
+	if err := balanceSub(bc.stub, StateKeyAllowedBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(allowedChange(token, pb.BalanceChange_ALLOWED, pb.BalanceChange_ALLOWED, address, nil, amount, reason))
 }
 
 // AllowedIndustrialBalanceTransfer transfers allowed balance from one address to another
 func (bc *BaseContract) AllowedIndustrialBalanceTransfer(from *types.Address, to *types.Address, industrialAssets []*pb.Asset, reason string) error {
+	if err := checkFrozen(bc.stub, from, to); err != nil {
+		return err
+	}
 	for _, industrialAsset := range industrialAssets {
 		amount := new(big.Int).SetBytes(industrialAsset.Amount)
 		if stub, ok := bc.GetStub().(*BatchTxStub); ok {
@@ -264,6 +378,9 @@ func (bc *BaseContract) AllowedIndustrialBalanceTransfer(from *types.Address, to
 		if err := balanceTransfer(bc.stub, StateKeyAllowedBalance, from, to, amount, industrialAsset.Group); err != nil {
 			return err
 		}
+		if err := bc.emitBalanceChange(allowedChange(industrialAsset.Group, pb.BalanceChange_ALLOWED, pb.BalanceChange_ALLOWED, from, to, amount, reason)); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -271,6 +388,9 @@ func (bc *BaseContract) AllowedIndustrialBalanceTransfer(from *types.Address, to
 
 // AllowedIndustrialBalanceAdd adds allowed balance to given address
 func (bc *BaseContract) AllowedIndustrialBalanceAdd(address *types.Address, industrialAssets []*pb.Asset, reason string) error {
+	if err := checkFrozen(bc.stub, nil, address); err != nil {
+		return err
+	}
 	for _, industrialAsset := range industrialAssets {
 		amount := new(big.Int).SetBytes(industrialAsset.Amount)
 		if stub, ok := bc.GetStub().(*BatchTxStub); ok {
@@ -279,6 +399,9 @@ func (bc *BaseContract) AllowedIndustrialBalanceAdd(address *types.Address, indu
 		if err := balanceAdd(bc.stub, StateKeyAllowedBalance, address, amount, industrialAsset.Group); err != nil {
 			return err
 		}
+		if err := bc.emitBalanceChange(allowedChange(industrialAsset.Group, pb.BalanceChange_ALLOWED, pb.BalanceChange_ALLOWED, nil, address, amount, reason)); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -286,6 +409,9 @@ func (bc *BaseContract) AllowedIndustrialBalanceAdd(address *types.Address, indu
 
 // AllowedIndustrialBalanceSub subtracts allowed balance from given address
 func (bc *BaseContract) AllowedIndustrialBalanceSub(address *types.Address, industrialAssets []*pb.Asset, reason string) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	for _, asset := range industrialAssets {
 		amount := new(big.Int).SetBytes(asset.Amount)
 		if stub, ok := bc.GetStub().(*BatchTxStub); ok {
@@ -294,6 +420,9 @@ func (bc *BaseContract) AllowedIndustrialBalanceSub(address *types.Address, indu
 		if err := balanceSub(bc.stub, StateKeyAllowedBalance, address, amount, asset.Group); err != nil {
 			return err
 		}
+		if err := bc.emitBalanceChange(allowedChange(asset.Group, pb.BalanceChange_ALLOWED, pb.BalanceChange_ALLOWED, address, nil, amount, reason)); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -301,37 +430,66 @@ func (bc *BaseContract) AllowedIndustrialBalanceSub(address *types.Address, indu
 
 // AllowedBalanceLock locks allowed balance for given address
 func (bc *BaseContract) AllowedBalanceLock(token string, address *types.Address, amount *big.Int) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	if err := balanceSub(bc.stub, StateKeyAllowedBalance, address, amount, token); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyLockedAllowedBalance, address, amount, token)
+	if err := balanceAdd(bc.stub, StateKeyLockedAllowedBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(allowedChange(token, pb.BalanceChange_ALLOWED, pb.BalanceChange_LOCKED_ALLOWED, address, address, amount, balanceLockReason))
 }
 
 // AllowedBalanceUnLock unlocks allowed balance for given address
 func (bc *BaseContract) AllowedBalanceUnLock(token string, address *types.Address, amount *big.Int) error {
+	if err := checkFrozen(bc.stub, nil, address); err != nil {
+		return err
+	}
 	if err := balanceSub(bc.stub, StateKeyLockedAllowedBalance, address, amount, token); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyAllowedBalance, address, amount, token)
+	if err := balanceAdd(bc.stub, StateKeyAllowedBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(allowedChange(token, pb.BalanceChange_LOCKED_ALLOWED, pb.BalanceChange_ALLOWED, address, address, amount, balanceUnlockReason))
 }
 
 // AllowedBalanceTransferLocked transfers locked allowed balance from one address to another
 func (bc *BaseContract) AllowedBalanceTransferLocked(token string, from *types.Address, to *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, from, to); err != nil {
+		return err
+	}
+	return bc.allowedBalanceTransferLocked(token, from, to, amount, reason)
+}
+
+// allowedBalanceTransferLocked transfers locked allowed balance without checks of frozen addresses
+func (bc *BaseContract) allowedBalanceTransferLocked(token string, from *types.Address, to *types.Address, amount *big.Int, reason string) error {
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(token, from, to, amount, reason)
 	}
 	if err := balanceSub(bc.stub, StateKeyLockedAllowedBalance, from, amount, token); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyAllowedBalance, to, amount, token)
+	if err := balanceAdd(bc.stub, StateKeyAllowedBalance, to, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(allowedChange(token, pb.BalanceChange_LOCKED_ALLOWED, pb.BalanceChange_ALLOWED, from, to, amount, reason))
 }
 
 // AllowedBalanceBurnLocked burns locked allowed balance for given address
 func (bc *BaseContract) AllowedBalanceBurnLocked(token string, address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(token, address, &types.Address{}, amount, reason)
 	}
-	return balanceSub(bc.stub, StateKeyLockedAllowedBalance, address, amount, token)
+	if err := balanceSub(bc.stub, StateKeyLockedAllowedBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(allowedChange(token, pb.BalanceChange_LOCKED_ALLOWED, pb.BalanceChange_LOCKED_ALLOWED, address, nil, amount, reason))
 }
 
 // IndustrialBalanceGetLocked returns locked industrial balance for given address
@@ -341,26 +499,46 @@ func (bc *BaseContract) IndustrialBalanceGetLocked(address *types.Address) (map[
 
 // IndustrialBalanceLock locks industrial balance for given address
 func (bc *BaseContract) IndustrialBalanceLock(token string, address *types.Address, amount *big.Int) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	parts := strings.Split(token, "_")
 	token = parts[len(parts)-1]
 	if err := balanceSub(bc.stub, StateKeyTokenBalance, address, amount, token); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyLockedTokenBalance, address, amount, token)
+	if err := balanceAdd(bc.stub, StateKeyLockedTokenBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.industrialChange(token, pb.BalanceChange_INDUSTRIAL, pb.BalanceChange_LOCKED_INDUSTRIAL, address, address, amount, balanceLockReason))
 }
 
 // IndustrialBalanceUnLock unlocks industrial balance for given address
 func (bc *BaseContract) IndustrialBalanceUnLock(token string, address *types.Address, amount *big.Int) error {
+	if err := checkFrozen(bc.stub, nil, address); err != nil {
+		return err
+	}
 	parts := strings.Split(token, "_")
 	token = parts[len(parts)-1]
 	if err := balanceSub(bc.stub, StateKeyLockedTokenBalance, address, amount, token); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyTokenBalance, address, amount, token)
+	if err := balanceAdd(bc.stub, StateKeyTokenBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.industrialChange(token, pb.BalanceChange_LOCKED_INDUSTRIAL, pb.BalanceChange_INDUSTRIAL, address, address, amount, balanceUnlockReason))
 }
 
 // IndustrialBalanceTransferLocked transfers locked industrial balance from one address to another
 func (bc *BaseContract) IndustrialBalanceTransferLocked(token string, from *types.Address, to *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, from, to); err != nil {
+		return err
+	}
+	return bc.industrialBalanceTransferLocked(token, from, to, amount, reason)
+}
+
+// industrialBalanceTransferLocked transfers locked industrial balance without checks of frozen addresses
+func (bc *BaseContract) industrialBalanceTransferLocked(token string, from *types.Address, to *types.Address, amount *big.Int, reason string) error {
 	parts := strings.Split(token, "_")
 	token = parts[len(parts)-1]
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
@@ -370,17 +548,26 @@ func (bc *BaseContract) IndustrialBalanceTransferLocked(token string, from *type
 	if err := balanceSub(bc.stub, StateKeyLockedTokenBalance, from, amount, token); err != nil {
 		return err
 	}
-	return balanceAdd(bc.stub, StateKeyTokenBalance, to, amount, token)
+	if err := balanceAdd(bc.stub, StateKeyTokenBalance, to, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.industrialChange(token, pb.BalanceChange_LOCKED_INDUSTRIAL, pb.BalanceChange_INDUSTRIAL, from, to, amount, reason))
 }
 
 // IndustrialBalanceBurnLocked burns locked industrial balance for given address
 func (bc *BaseContract) IndustrialBalanceBurnLocked(token string, address *types.Address, amount *big.Int, reason string) error {
+	if err := checkFrozen(bc.stub, address, nil); err != nil {
+		return err
+	}
 	parts := strings.Split(token, "_")
 	token = parts[len(parts)-1]
 	if stub, ok := bc.GetStub().(*BatchTxStub); ok {
 		stub.AddAccountingRecord(bc.id+"_"+token, address, &types.Address{}, amount, reason)
 	}
-	return balanceSub(bc.stub, StateKeyLockedTokenBalance, address, amount, token)
+	if err := balanceSub(bc.stub, StateKeyLockedTokenBalance, address, amount, token); err != nil {
+		return err
+	}
+	return bc.emitBalanceChange(bc.industrialChange(token, pb.BalanceChange_LOCKED_INDUSTRIAL, pb.BalanceChange_LOCKED_INDUSTRIAL, address, nil, amount, reason))
 }
 
 // AllowedBalanceGetAll returns all allowed balances for given address
@@ -407,7 +594,11 @@ func GivenBalanceAdd(stub shim.ChaincodeStubInterface, contract string, amount *
 	if err != nil {
 		return err
 	}
-	return stub.PutState(key, new(big.Int).Add(balance, amount).Bytes())
+	balance = new(big.Int).Add(balance, amount)
+	if err = stub.PutState(key, balance.Bytes()); err != nil {
+		return err
+	}
+	return emitGivenBalanceChange(stub, contract, true, amount, balance, givenBalanceAddReason)
 }
 
 // GivenBalanceSub subtracts given balance from given contract
@@ -419,5 +610,9 @@ func GivenBalanceSub(stub shim.ChaincodeStubInterface, contract string, amount *
 	if balance.Cmp(amount) < 0 {
 		return errors.New("insufficient funds to process")
 	}
-	return stub.PutState(key, new(big.Int).Sub(balance, amount).Bytes())
+	balance = new(big.Int).Sub(balance, amount)
+	if err = stub.PutState(key, balance.Bytes()); err != nil {
+		return err
+	}
+	return emitGivenBalanceChange(stub, contract, false, amount, balance, givenBalanceSubReason)
 }
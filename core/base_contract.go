@@ -2,7 +2,7 @@ package core
 
 import (
 	"embed"
-	"encoding/hex"
+	"errors"
 	"fmt"
 	"runtime/debug"
 	"sort"
@@ -12,21 +12,32 @@ import (
 	"github.com/atomyze-foundation/foundation/core/types/big"
 	pb "github.com/atomyze-foundation/foundation/proto"
 	"github.com/atomyze-foundation/foundation/version"
-	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 )
 
 // BaseContract is a base contract for all contracts
 type BaseContract struct {
-	id          string
-	stub        shim.ChaincodeStubInterface
-	methods     []string
-	atomyzeSKI  []byte
-	initArgs    []string
-	noncePrefix StateKey
+	id         string
+	stub       shim.ChaincodeStubInterface
+	methods    []string
+	atomyzeSKI []byte
+	initArgs   []string
+	nonce      nonceSettings
+	journal    journalSettings
+	migrations map[string][]Migration
+	// batchPrefix is the prefix of preimages of batched transactions
+	batchPrefix string
 	srcFs       *embed.FS
 }
 
+// contractSettings are settings of the chaincode passed to every copy of the contract
+type contractSettings struct {
+	nonce       nonceSettings
+	journal     journalSettings
+	migrations  map[string][]Migration
+	batchPrefix string
+}
+
 func (bc *BaseContract) baseContractInit(cc BaseContractInterface) { //nolint:unused
 	bc.id = cc.GetID()
 }
@@ -54,12 +65,31 @@ func (bc *BaseContract) setStubAndInitArgs( //nolint:unused
 	stub shim.ChaincodeStubInterface,
 	atomyzeSKI []byte,
 	args []string,
-	noncePrefix StateKey,
+	settings contractSettings,
 ) {
 	bc.stub = stub
 	bc.atomyzeSKI = atomyzeSKI
 	bc.initArgs = args
-	bc.noncePrefix = noncePrefix
+	bc.nonce = settings.nonce
+	bc.journal = settings.journal
+	bc.migrations = settings.migrations
+	bc.batchPrefix = settings.batchPrefix
+}
+
+// checkAdmin returns errNotAdmin if the sender isn't the chaincode admin
+func (bc *BaseContract) checkAdmin(sender *types.Sender, errNotAdmin error) error {
+	admin, err := bc.GetRoleHolder(RoleAdmin)
+	if errors.Is(err, ErrRoleNotSet) {
+		return errNotAdmin
+	}
+	if err != nil {
+		return err
+	}
+
+	if !sender.Equal(admin) {
+		return errNotAdmin
+	}
+	return nil
 }
 
 // GetAtomyzeSKI returns atomyzeSKI
@@ -83,31 +113,30 @@ func (bc *BaseContract) GetInitArgsLen() int {
 
 // QueryGetNonce returns nonce
 func (bc *BaseContract) QueryGetNonce(owner *types.Address) (string, error) {
-	prefix := hex.EncodeToString([]byte{byte(bc.noncePrefix)})
-	key, err := bc.stub.CreateCompositeKey(prefix, []string{owner.String()})
-	if err != nil {
-		return "", err
-	}
-
-	data, err := bc.stub.GetState(key)
+	lastNonce, _, err := bc.nonce.load(bc.stub, owner)
 	if err != nil {
 		return "", err
 	}
 
 	exist := new(big.Int).String()
-
-	lastNonce := new(pb.Nonce)
-	if len(data) > 0 {
-		if err = proto.Unmarshal(data, lastNonce); err != nil {
-			// let's just say it's an old nonsense
-			lastNonce.Nonce = []uint64{new(big.Int).SetBytes(data).Uint64()}
-		}
+	if len(lastNonce.Nonce) > 0 {
 		exist = strconv.FormatUint(lastNonce.Nonce[len(lastNonce.Nonce)-1], 10)
 	}
 
 	return exist, nil
 }
 
+// QueryGetNonceWindow returns all nonces of the owner inside the nonce TTL window
+// with the window boundaries and the active nonce prefix
+func (bc *BaseContract) QueryGetNonceWindow(owner *types.Address) (*NonceWindow, error) {
+	return bc.nonce.window(bc.stub, owner)
+}
+
+// QueryCheckNonce checks whether the nonce would be accepted for the owner. Nothing is saved
+func (bc *BaseContract) QueryCheckNonce(owner *types.Address, nonce uint64) (*NonceCheckResult, error) {
+	return bc.nonce.dryRun(bc.stub, owner, nonce)
+}
+
 // QuerySrcFile returns file
 func (bc *BaseContract) QuerySrcFile(name string) (string, error) {
 	if bc.srcFs == nil {
@@ -235,9 +264,9 @@ type BaseContractInterface interface { //nolint:interfacebloat
 
 	addMethod(string)
 	baseContractInit(BaseContractInterface)
-	setStubAndInitArgs(stub shim.ChaincodeStubInterface, atomyzeSKI []byte, args []string, noncePrefix StateKey)
+	setStubAndInitArgs(stub shim.ChaincodeStubInterface, atomyzeSKI []byte, args []string, settings contractSettings)
 	setSrcFs(*embed.FS)
-	tokenBalanceAdd(address *types.Address, amount *big.Int, token string) error
+	tokenBalanceAdd(address *types.Address, amount *big.Int, token string, reason string) error
 
 	// ------------------------------------------------------------------
 
@@ -3,6 +3,7 @@ package core
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"runtime/debug"
 	"sort"
@@ -89,18 +90,15 @@ func (cc *ChainCode) loadFromBatch( //nolint:funlen
 		}
 	}()
 
-	pending := new(proto.PendingTx)
-	if err = pb.Unmarshal(data, pending); err != nil {
-		var args []string
-		if err = json.Unmarshal(data, &args); err != nil {
-			logger.Errorf("Couldn't unmarshal transaction %s: %s", txID, err.Error())
-			return nil, key, err
-		}
-
-		pending = &proto.PendingTx{
-			Method: args[0],
-			Args:   args[2:],
-		}
+	// JSON preimages are read until they're rewritten by the core migration
+	migratedPreimages, err := migrated(stub, MigrationTrackCore, coreMigrationLegacyPreimages)
+	if err != nil {
+		return nil, key, err
+	}
+	pending, _, err := unmarshalPendingTx(data, !migratedPreimages)
+	if err != nil {
+		logger.Errorf("Couldn't unmarshal transaction %s: %s", txID, err.Error())
+		return nil, key, err
 	}
 
 	if cc.txTTL > 0 && batchTimestamp-pending.Timestamp > int64(cc.txTTL) {
@@ -146,6 +144,10 @@ func (cc *ChainCode) batchExecute(
 	start := time.Now()
 	defer func() {
 		logger.Infof("batch %s elapsed time %d ms", batchID, time.Since(start).Milliseconds())
+		if cacheStub, ok := stub.(*aclCacheStub); ok {
+			stats := cacheStub.Stats()
+			logger.Infof("batch %s acl cache hits %d misses %d", batchID, stats.Hits, stats.Misses)
+		}
 	}()
 	response := proto.BatchResponse{}
 	events := proto.BatchEvent{}
@@ -161,6 +163,12 @@ func (cc *ChainCode) batchExecute(
 		return shim.Error(err.Error())
 	}
 
+	pause, err := getPause(stub)
+	if err != nil {
+		logger.Errorf("Couldn't load pause for batch %s: %s", batchID, err.Error())
+		return shim.Error(err.Error())
+	}
+
 	for _, txID := range batch.TxIDs {
 		resp, event := cc.batchedTxExecute(btchStub, txID, batchTimestamp.Seconds, atomyzeSKI, initArgs)
 		response.TxResponses = append(response.TxResponses, resp)
@@ -169,6 +177,10 @@ func (cc *ChainCode) batchExecute(
 
 	if !cc.disableSwaps {
 		for _, swap := range batch.Swaps {
+			if pause.Paused && pause.BlockSwaps {
+				response.SwapResponses = append(response.SwapResponses, pausedSwapResponse(swap.Id))
+				continue
+			}
 			response.SwapResponses = append(response.SwapResponses, swapAnswer(btchStub, swap))
 		}
 		for _, swapKey := range batch.Keys {
@@ -178,6 +190,10 @@ func (cc *ChainCode) batchExecute(
 
 	if !cc.disableMultiSwaps {
 		for _, swap := range batch.MultiSwaps {
+			if pause.Paused && pause.BlockSwaps {
+				response.SwapResponses = append(response.SwapResponses, pausedSwapResponse(swap.Id))
+				continue
+			}
 			response.SwapResponses = append(response.SwapResponses, multiSwapAnswer(btchStub, swap))
 		}
 		for _, swapKey := range batch.MultiSwapsKeys {
@@ -264,6 +280,13 @@ func (cc *ChainCode) batchedTxExecute( //nolint:funlen
 	}
 	methodName = pending.Method
 
+	// the pause could be set after the transaction was added to the batch
+	if err = checkPaused(txStub, pending.Method); err != nil {
+		_ = stub.ChaincodeStubInterface.DelState(key)
+		ee := proto.ResponseError{Error: err.Error()}
+		return &proto.TxResponse{Id: binaryTxID, Method: pending.Method, Error: &ee}, &proto.BatchTxEvent{Id: binaryTxID, Method: pending.Method, Error: &ee}
+	}
+
 	response, err := cc.callMethod(txStub, method, pending.Sender, pending.Args, atomyzeSKI, initArgs)
 	if err != nil {
 		_ = stub.ChaincodeStubInterface.DelState(key)
@@ -290,3 +313,25 @@ func (cc *ChainCode) batchedTxExecute( //nolint:funlen
 			Result:     response,
 		}
 }
+
+// unmarshalPendingTx parses the preimage of the batched transaction, legacy is true
+// if the preimage is stored as JSON []string of the method, the sender and args
+func unmarshalPendingTx(data []byte, legacyEnabled bool) (*proto.PendingTx, bool, error) {
+	pending := new(proto.PendingTx)
+	err := pb.Unmarshal(data, pending)
+	if err == nil || !legacyEnabled {
+		return pending, false, err
+	}
+
+	var args []string
+	if err = json.Unmarshal(data, &args); err != nil {
+		return nil, false, err
+	}
+	if len(args) < 2 { //nolint:gomnd
+		return nil, false, errors.New("legacy preimage must contain the method and the sender")
+	}
+	return &proto.PendingTx{
+		Method: args[0],
+		Args:   args[2:],
+	}, true, nil
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"sort"
 
+	"github.com/atomyze-foundation/foundation/core/helpers"
 	"github.com/atomyze-foundation/foundation/core/types"
 	"github.com/atomyze-foundation/foundation/core/types/big"
 	"github.com/atomyze-foundation/foundation/proto"
@@ -23,6 +24,11 @@ func newBatchStub(stub shim.ChaincodeStubInterface) *batchStub {
 	}
 }
 
+// ACLProvider returns the ACL provider of the wrapped stub
+func (bs *batchStub) ACLProvider() helpers.ACLProvider {
+	return helpers.ACL(bs.ChaincodeStubInterface)
+}
+
 // GetState returns state from batchStub cache or, if absent, from chaincode state
 func (bs *batchStub) GetState(key string) ([]byte, error) {
 	existsElement, ok := bs.batchCache[key]
@@ -67,6 +73,8 @@ type BatchTxStub struct {
 	txCache    map[string]*proto.WriteElement
 	events     map[string][]byte
 	accounting []*proto.AccountingRecord
+
+	balanceChanges []*proto.BalanceChange
 }
 
 func (bs *batchStub) newTxStub(txID string) *BatchTxStub {
@@ -52,18 +52,8 @@ func (bc *BaseContract) TxChannelTransferByAdmin(
 	amount *big.Int,
 ) (string, error) {
 	// Checks
-	l := bc.GetInitArgsLen()
-	if l < 1 {
-		return "", cctransfer.ErrNotFoundAdminKey
-	}
-
-	admin, err := types.AddrFromBase58Check(bc.GetInitArg(argPositionAdmin))
-	if err != nil {
-		panic(err)
-	}
-
-	if !sender.Equal(admin) {
-		return "", cctransfer.ErrNotFoundAdminKey
+	if err := bc.checkAdmin(sender, cctransfer.ErrNotFoundAdminKey); err != nil {
+		return "", err
 	}
 
 	if sender.Equal(idUser) {
@@ -114,6 +104,10 @@ func (bc *BaseContract) createCCTransferFrom(
 		TimeAsNanos:      ts.AsTime().UnixNano(),
 	}
 
+	if err = bc.ChargeVelocity(idUser, token, amount); err != nil {
+		return "", err
+	}
+
 	if err = cctransfer.SaveCCFromTransfer(stub, tr); err != nil {
 		return "", err
 	}
@@ -347,7 +341,7 @@ func (bc *BaseContract) ccTransferChangeBalance( //nolint:funlen,gocognit
 	switch t {
 	case CreateFrom:
 		if forwardDirection {
-			if err = bc.tokenBalanceSub(user, amount, token); err != nil {
+			if err = bc.tokenBalanceSub(user, amount, token, "ch-transfer"); err != nil {
 				return err
 			}
 			if err = GivenBalanceAdd(bc.GetStub(), to, amount); err != nil {
@@ -364,7 +358,7 @@ func (bc *BaseContract) ccTransferChangeBalance( //nolint:funlen,gocognit
 				return err
 			}
 		} else {
-			if err = bc.tokenBalanceAdd(user, amount, token); err != nil {
+			if err = bc.tokenBalanceAdd(user, amount, token, "ch-transfer"); err != nil {
 				return err
 			}
 			if err = GivenBalanceSub(bc.GetStub(), from, amount); err != nil {
@@ -373,7 +367,7 @@ func (bc *BaseContract) ccTransferChangeBalance( //nolint:funlen,gocognit
 		}
 	case CancelFrom:
 		if forwardDirection {
-			if err = bc.tokenBalanceAdd(user, amount, token); err != nil {
+			if err = bc.tokenBalanceAdd(user, amount, token, "cancel ch-transfer"); err != nil {
 				return err
 			}
 			if err = GivenBalanceSub(bc.GetStub(), to, amount); err != nil {
@@ -16,6 +16,7 @@ import (
 	"reflect"
 	"runtime/debug"
 
+	"github.com/atomyze-foundation/foundation/core/helpers"
 	"github.com/atomyze-foundation/foundation/core/initialize"
 	"github.com/atomyze-foundation/foundation/core/types"
 	"github.com/atomyze-foundation/foundation/proto"
@@ -46,20 +47,25 @@ type ChaincodeOption func(opts *chaincodeOptions) error
 
 // opts allows the user to specify more advanced options
 type chaincodeOptions struct {
-	SrcFs *embed.FS
+	SrcFs       *embed.FS
+	ACLProvider helpers.ACLProvider
 }
 
 // ChainCode is a chaincode	struct which implements shim.Chaincode interface
 type ChainCode struct {
-	contract          BaseContractInterface
-	methods           map[string]*Fn
-	disableSwaps      bool
-	disableMultiSwaps bool
-	txTTL             uint
-	batchPrefix       string
-	nonceTTL          uint
-	noncePrefix       StateKey
-	nonceCheckFn      NonceCheckFn
+	contract           BaseContractInterface
+	methods            map[string]*Fn
+	disableSwaps       bool
+	disableMultiSwaps  bool
+	txTTL              uint
+	batchPrefix        string
+	nonceTTL           uint
+	noncePrefix        StateKey
+	disableLegacyNonce bool
+	nonceCheckFn       NonceCheckFn
+	journal            journalSettings
+	aclProvider        helpers.ACLProvider
+	migrations         map[string][]Migration
 }
 
 // WithSrcFS specifies a set src fs
@@ -70,13 +76,38 @@ func WithSrcFS(fs *embed.FS) ChaincodeOption {
 	}
 }
 
+// WithACLProvider specifies a source of addresses and account information
+// instead of the ACL chaincode in the "acl" channel
+func WithACLProvider(provider helpers.ACLProvider) ChaincodeOption {
+	return func(o *chaincodeOptions) error {
+		if provider == nil {
+			return errors.New("acl provider is nil")
+		}
+		o.ACLProvider = provider
+		return nil
+	}
+}
+
+// WithACLChaincode specifies channel and chaincode names of the ACL chaincode
+func WithACLChaincode(channel string, chaincode string) ChaincodeOption {
+	return func(o *chaincodeOptions) error {
+		if channel == "" || chaincode == "" {
+			return errors.New("acl channel and chaincode names must not be empty")
+		}
+		o.ACLProvider = helpers.NewChaincodeACLProvider(channel, chaincode)
+		return nil
+	}
+}
+
 // NewCC creates new ChainCode
 func NewCC(
 	cc BaseContractInterface,
 	options *ContractOptions,
 	chOptions ...ChaincodeOption,
 ) (*ChainCode, error) {
-	chOpts := chaincodeOptions{}
+	chOpts := chaincodeOptions{
+		ACLProvider: helpers.NewChaincodeACLProvider(helpers.DefaultACLChannel, helpers.DefaultACLChaincode),
+	}
 	for _, option := range chOptions {
 		err := option(&chOpts)
 		if err != nil {
@@ -97,7 +128,8 @@ func NewCC(
 		methods:      methods,
 		batchPrefix:  batchKey,
 		noncePrefix:  StateKeyNonce,
-		nonceCheckFn: checkNonce(0, StateKeyNonce),
+		nonceCheckFn: checkNonce(nonceSettings{prefix: StateKeyNonce}),
+		aclProvider:  chOpts.ACLProvider,
 	}
 
 	if options != nil {
@@ -113,16 +145,57 @@ func NewCC(
 		if options.IsOtherNoncePrefix {
 			out.noncePrefix = StateKeyPassedNonce
 		}
+		out.disableLegacyNonce = options.DisableLegacyNonce
+		out.journal = journalSettings{
+			enabled:   options.EnableJournal,
+			retention: options.JournalRetention,
+		}
 
-		out.nonceCheckFn = checkNonce(out.nonceTTL, out.noncePrefix)
+		out.nonceCheckFn = checkNonce(out.nonceSettings())
+	}
+
+	out.migrations = map[string][]Migration{MigrationTrackCore: coreMigrations}
+	if provider, ok := cc.(MigrationsProvider); ok {
+		out.migrations[MigrationTrackContract] = provider.Migrations()
+	}
+	for track, migrations := range out.migrations {
+		if err = verifyMigrations(migrations); err != nil {
+			return &ChainCode{}, fmt.Errorf("%s migrations: %w", track, err)
+		}
 	}
 
 	return out, nil
 }
 
-// Init initializes chaincode
+func (cc *ChainCode) contractSettings() contractSettings {
+	return contractSettings{
+		nonce:       cc.nonceSettings(),
+		journal:     cc.journal,
+		migrations:  cc.migrations,
+		batchPrefix: cc.batchPrefix,
+	}
+}
+
+func (cc *ChainCode) nonceSettings() nonceSettings {
+	return nonceSettings{
+		prefix:         cc.noncePrefix,
+		ttl:            cc.nonceTTL,
+		legacyDisabled: cc.disableLegacyNonce,
+	}
+}
+
+// InitSchemaProvider is implemented by contracts which name and validate their init args
+type InitSchemaProvider interface {
+	InitSchema() *initialize.Schema
+}
+
+// Init initializes chaincode, init args are validated by the init schema of the contract if it has one
 func (cc *ChainCode) Init(stub shim.ChaincodeStubInterface) peer.Response {
-	err := initialize.InitChaincode(stub)
+	var schema *initialize.Schema
+	if provider, ok := cc.contract.(InitSchemaProvider); ok {
+		schema = provider.InitSchema()
+	}
+	err := initialize.InitChaincodeWithSchema(stub, schema)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -139,6 +212,9 @@ func (cc *ChainCode) Invoke(stub shim.ChaincodeStubInterface) (r peer.Response)
 		}
 	}()
 
+	// ACL responses are cached only until the end of the current invocation
+	stub = newACLCacheStub(stub, cc.aclProvider)
+
 	err := cc.ValidateTxID(stub)
 	if err != nil {
 		return shim.Error(err.Error())
@@ -153,10 +229,18 @@ func (cc *ChainCode) Invoke(stub shim.ChaincodeStubInterface) (r peer.Response)
 	switch functionName {
 	case "batchExecute":
 		return cc.batchExecuteHandler(stub, creatorSKI, hashedCert, args)
-	case "swapDone":
-		return cc.swapDoneHandler(stub, args)
-	case "multiSwapDone":
-		return cc.multiSwapDoneHandler(stub, args)
+	case swapDoneMethod:
+		if err = checkPaused(stub, functionName); err != nil {
+			return shim.Error(err.Error())
+		}
+		eventStub := newBalanceEventStub(stub)
+		return eventStub.flush(cc.swapDoneHandler(eventStub, args))
+	case multiSwapDoneMethod:
+		if err = checkPaused(stub, functionName); err != nil {
+			return shim.Error(err.Error())
+		}
+		eventStub := newBalanceEventStub(stub)
+		return eventStub.flush(cc.multiSwapDoneHandler(eventStub, args))
 	case "createCCTransferTo", "cancelCCTransferFrom", "commitCCTransferFrom",
 		"deleteCCTransferFrom", "deleteCCTransferTo":
 		initArgs, err := initialize.LoadInitArgs(stub)
@@ -176,9 +260,21 @@ func (cc *ChainCode) Invoke(stub shim.ChaincodeStubInterface) (r peer.Response)
 		return shim.Error(err.Error())
 	}
 
+	// queries work during the pause
+	if !fn.query {
+		if err = checkPaused(stub, functionName); err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
 	// handle invoke and query methods executed without batch process
 	if fn.noBatch {
-		return cc.noBatchHandler(stub, functionName, fn, args)
+		if fn.query {
+			return cc.noBatchHandler(stub, functionName, fn, args)
+		}
+		// balance changes of the transaction are delivered as its event
+		eventStub := newBalanceEventStub(stub)
+		return eventStub.flush(cc.noBatchHandler(eventStub, functionName, fn, args))
 	}
 
 	// handle invoke method with batch process
@@ -293,7 +389,7 @@ func (cc *ChainCode) multiSwapDoneHandler(stub shim.ChaincodeStubInterface, args
 	if err != nil {
 		return shim.Error(fmt.Sprintf("incorrect tx id %s", err.Error()))
 	}
-	_, contract := copyContract(cc.contract, stub, initArgs.AtomyzeSKI, initArgs.Args, cc.noncePrefix)
+	_, contract := copyContract(cc.contract, stub, initArgs.AtomyzeSKI, initArgs.Args, cc.contractSettings())
 	return multiSwapUserDone(contract, args[0], args[1])
 }
 
@@ -305,7 +401,7 @@ func (cc *ChainCode) swapDoneHandler(stub shim.ChaincodeStubInterface, args []st
 	if err != nil {
 		return shim.Error(fmt.Sprintf("incorrect tx id %s", err.Error()))
 	}
-	_, contract := copyContract(cc.contract, stub, initArgs.AtomyzeSKI, initArgs.Args, cc.noncePrefix)
+	_, contract := copyContract(cc.contract, stub, initArgs.AtomyzeSKI, initArgs.Args, cc.contractSettings())
 	return swapUserDone(contract, args[0], args[1])
 }
 
@@ -341,7 +437,7 @@ func (cc *ChainCode) callMethod(
 		}, values...)
 	}
 
-	contract, _ := copyContract(cc.contract, stub, atomyzeSKI, initArgs, cc.noncePrefix)
+	contract, _ := copyContract(cc.contract, stub, atomyzeSKI, initArgs, cc.contractSettings())
 
 	out := method.fn.Call(append([]reflect.Value{contract}, values...))
 	errInt := out[0].Interface()
@@ -453,7 +549,7 @@ func copyContract(
 	stub shim.ChaincodeStubInterface,
 	atomyzeSKI []byte,
 	initArgs []string,
-	noncePrefix StateKey,
+	settings contractSettings,
 ) (reflect.Value, BaseContractInterface) {
 	cp := reflect.New(reflect.ValueOf(orig).Elem().Type())
 	val := reflect.ValueOf(orig).Elem()
@@ -466,7 +562,7 @@ func copyContract(
 	if !ok {
 		return cp, nil
 	}
-	contract.setStubAndInitArgs(stub, atomyzeSKI, initArgs, noncePrefix)
+	contract.setStubAndInitArgs(stub, atomyzeSKI, initArgs, settings)
 	return cp, contract
 }
 
@@ -0,0 +1,398 @@
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/proto"
+)
+
+// ErrIndustrialTokenRequired - error on lock of industrial balance without group in the token
+// or with the ticker of another contract
+var ErrIndustrialTokenRequired = errors.New("industrial token of the contract with group required")
+
+const (
+	// BalanceIndustrialLockedEvent - event on industrial balance locked
+	BalanceIndustrialLockedEvent = "BalanceIndustrialLocked"
+	// BalanceIndustrialUnlockedEvent - event on industrial balance unlocked
+	BalanceIndustrialUnlockedEvent = "BalanceIndustrialUnlocked"
+	// BalanceIndustrialLockExecutedEvent - event on locked industrial balance transferred to the beneficiary
+	BalanceIndustrialLockExecutedEvent = "BalanceIndustrialLockExecuted"
+)
+
+// verifyIndustrialToken checks that the token of the request is an industrial token of the contract, e.g. ticker_group
+func (bc *BaseContract) verifyIndustrialToken(req *proto.BalanceLockRequest) error {
+	parts := strings.Split(req.Token, "_")
+	if len(parts) < 2 || parts[len(parts)-1] == "" || !strings.EqualFold(tokenSymbol(req.Token), bc.id) { //nolint:gomnd
+		return ErrIndustrialTokenRequired
+	}
+	return nil
+}
+
+// TxLockIndustrialBalance - blocks tokens on the user's industrial balance of the group,
+// method is called by the chaincode admin, the input is BalanceLockRequest with the industrial token (ticker_group)
+func (bc *BaseContract) TxLockIndustrialBalance( //nolint:funlen
+	sender *types.Sender,
+	req *proto.BalanceLockRequest,
+) error {
+	if req.Id == "" {
+		req.Id = bc.stub.GetTxID()
+	}
+
+	err := bc.verifyLockedArgs(sender, req)
+	if err != nil {
+		return err
+	}
+
+	if err = bc.verifyIndustrialToken(req); err != nil {
+		return err
+	}
+
+	if err = bc.verifyLockTerms(req); err != nil {
+		return err
+	}
+
+	// Check what's already there
+	_, err = bc.getLockedIndustrialBalance(req.Id)
+	if err == nil {
+		return ErrAlredyExist
+	}
+
+	address, err := types.AddrFromBase58Check(req.Address)
+	if err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+
+	amount, ok := new(big.Int).SetString(req.Amount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	if err = bc.IndustrialBalanceLock(req.Token, address, amount); err != nil {
+		return err
+	}
+
+	// state record with balance lock details
+	balanceLock := &proto.TokenBalanceLock{
+		Id:            req.Id,
+		Address:       req.Address,
+		Token:         req.Token,
+		InitAmount:    req.Amount,
+		CurrentAmount: req.Amount,
+		Reason:        req.Reason,
+		Docs:          req.Docs,
+		Payload:       req.Payload,
+		ExpiresAt:     req.ExpiresAt,
+		Beneficiary:   req.Beneficiary,
+		Tag:           req.Tag,
+	}
+
+	prefix := hex.EncodeToString([]byte{byte(StateKeyExternalLockedIndustrial)})
+	key, err := bc.stub.CreateCompositeKey(prefix, []string{balanceLock.Id})
+	if err != nil {
+		return fmt.Errorf("create key: %w", err)
+	}
+
+	data, err := json.Marshal(balanceLock)
+	if err != nil {
+		return fmt.Errorf("json marshal: %w", err)
+	}
+
+	balanceLockedEvent := &proto.TokenBalanceLocked{
+		Id:          balanceLock.Id,
+		Address:     balanceLock.Address,
+		Token:       balanceLock.Token,
+		Amount:      balanceLock.CurrentAmount,
+		Reason:      balanceLock.Reason,
+		Docs:        balanceLock.Docs,
+		Payload:     balanceLock.Payload,
+		ExpiresAt:   balanceLock.ExpiresAt,
+		Beneficiary: balanceLock.Beneficiary,
+	}
+	event, err := json.Marshal(balanceLockedEvent)
+	if err != nil {
+		return err
+	}
+
+	if err = bc.stub.SetEvent(BalanceIndustrialLockedEvent, event); err != nil {
+		return err
+	}
+
+	if err = bc.indexLock(LockKindIndustrial, balanceLock); err != nil {
+		return err
+	}
+
+	return bc.stub.PutState(key, data)
+}
+
+// TxUnlockIndustrialBalance - unblocks (fully or partially) tokens on the user's industrial balance of the group,
+// method is called by the chaincode admin, the input is BalanceLockRequest
+func (bc *BaseContract) TxUnlockIndustrialBalance(
+	sender *types.Sender,
+	req *proto.BalanceLockRequest,
+) error {
+	err := bc.verifyLockedArgs(sender, req)
+	if err != nil {
+		return err
+	}
+
+	// Check what's already there
+	balanceLock, err := bc.getLockedIndustrialBalance(req.Id)
+	if err != nil {
+		return err
+	}
+
+	address, err := types.AddrFromBase58Check(req.Address)
+	if err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+
+	amount, ok := new(big.Int).SetString(req.Amount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	return bc.unlockIndustrialBalance(balanceLock, address, amount)
+}
+
+// unlockIndustrialBalance unlocks the amount of the lock and deletes the lock if it's fully unlocked
+func (bc *BaseContract) unlockIndustrialBalance(balanceLock *proto.TokenBalanceLock, address *types.Address, amount *big.Int) error {
+	cur, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	isDelete := false
+	c := cur.Cmp(amount)
+	switch {
+	case c < 0:
+		return ErrInsufficientFunds
+	case c == 0:
+		isDelete = true
+	}
+
+	if err := bc.IndustrialBalanceUnLock(balanceLock.Token, address, amount); err != nil {
+		return err
+	}
+
+	// state record with balance lock details
+	balanceLock.CurrentAmount = new(big.Int).Sub(cur, amount).String()
+
+	prefix := hex.EncodeToString([]byte{byte(StateKeyExternalLockedIndustrial)})
+	key, err := bc.stub.CreateCompositeKey(prefix, []string{balanceLock.Id})
+	if err != nil {
+		return fmt.Errorf("create key: %w", err)
+	}
+
+	data, err := json.Marshal(balanceLock)
+	if err != nil {
+		return fmt.Errorf("json marshal: %w", err)
+	}
+
+	balanceLockedEvent := &proto.TokenBalanceUnlocked{
+		Id:                balanceLock.Id,
+		Address:           balanceLock.Address,
+		Token:             balanceLock.Token,
+		Amount:            balanceLock.CurrentAmount,
+		Reason:            balanceLock.Reason,
+		Docs:              balanceLock.Docs,
+		Payload:           balanceLock.Payload,
+		CompleteOperation: isDelete,
+	}
+	event, err := json.Marshal(balanceLockedEvent)
+	if err != nil {
+		return err
+	}
+
+	if err = bc.stub.SetEvent(BalanceIndustrialUnlockedEvent, event); err != nil {
+		return err
+	}
+
+	if isDelete {
+		if err = bc.unindexLock(LockKindIndustrial, balanceLock); err != nil {
+			return err
+		}
+		return bc.stub.DelState(key)
+	}
+	return bc.stub.PutState(key, data)
+}
+
+// QueryGetLockedIndustrialBalance - returns an existing industrial balance lock
+func (bc *BaseContract) QueryGetLockedIndustrialBalance(
+	lockID string,
+) (*proto.TokenBalanceLock, error) {
+	return bc.getLockedIndustrialBalance(lockID)
+}
+
+func (bc *BaseContract) getLockedIndustrialBalance(lockID string) (*proto.TokenBalanceLock, error) {
+	if lockID == "" {
+		return nil, ErrEmptyLockID
+	}
+	prefix := hex.EncodeToString([]byte{byte(StateKeyExternalLockedIndustrial)})
+	key, err := bc.stub.CreateCompositeKey(prefix, []string{lockID})
+	if err != nil {
+		return nil, fmt.Errorf("create key: %w", err)
+	}
+
+	data, err := bc.stub.GetState(key)
+	if err != nil {
+		return nil, fmt.Errorf("get industrial balance lock from state: %w", err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("lock id=%s: %w", lockID, ErrLockNotExists)
+	}
+
+	balanceLock := &proto.TokenBalanceLock{}
+	if err = json.Unmarshal(data, balanceLock); err != nil {
+		return nil, fmt.Errorf("unmarshal industrial balance lock state: %w", err)
+	}
+
+	return balanceLock, nil
+}
+
+// NBTxReleaseExpiredIndustrialBalanceLock - returns tokens of the expired lock to the owner's industrial balance,
+// method can be called by anyone (e.g. a robot) after the lock expired
+func (bc *BaseContract) NBTxReleaseExpiredIndustrialBalanceLock(lockID string) error {
+	balanceLock, err := bc.getLockedIndustrialBalance(lockID)
+	if err != nil {
+		return err
+	}
+
+	expired, err := bc.lockExpired(balanceLock.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	if !expired {
+		return ErrLockNotExpired
+	}
+
+	address, err := types.AddrFromBase58Check(balanceLock.Address)
+	if err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+
+	amount, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	return bc.unlockIndustrialBalance(balanceLock, address, amount)
+}
+
+// TxExecuteIndustrialBalanceLock - transfers (fully or partially) locked industrial tokens to the beneficiary
+// of the lock or to the beneficiary of the request if the lock doesn't have one.
+// method is called by the chaincode admin, the input is BalanceLockRequest
+func (bc *BaseContract) TxExecuteIndustrialBalanceLock( //nolint:funlen
+	sender *types.Sender,
+	req *proto.BalanceLockRequest,
+) error {
+	err := bc.verifyLockedArgs(sender, req)
+	if err != nil {
+		return err
+	}
+
+	balanceLock, err := bc.getLockedIndustrialBalance(req.Id)
+	if err != nil {
+		return err
+	}
+
+	expired, err := bc.lockExpired(balanceLock.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	if expired {
+		return ErrLockExpired
+	}
+
+	if err = verifyExecutedLock(req, balanceLock.Address, balanceLock.Token); err != nil {
+		return err
+	}
+
+	beneficiary, err := lockBeneficiary(balanceLock.Beneficiary, req)
+	if err != nil {
+		return err
+	}
+
+	address, err := types.AddrFromBase58Check(balanceLock.Address)
+	if err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+
+	amount, ok := new(big.Int).SetString(req.Amount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+	if amount.Sign() <= 0 {
+		return ErrLockAmountNotPositive
+	}
+
+	cur, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	isDelete := false
+	c := cur.Cmp(amount)
+	switch {
+	case c < 0:
+		return ErrInsufficientFunds
+	case c == 0:
+		isDelete = true
+	}
+
+	// the admin executes locks of frozen owners, only the beneficiary is checked
+	if err = checkFrozen(bc.stub, nil, beneficiary); err != nil {
+		return err
+	}
+	if err = bc.industrialBalanceTransferLocked(balanceLock.Token, address, beneficiary, amount, balanceLock.Reason); err != nil {
+		return err
+	}
+
+	// state record with balance lock details
+	balanceLock.CurrentAmount = new(big.Int).Sub(cur, amount).String()
+
+	prefix := hex.EncodeToString([]byte{byte(StateKeyExternalLockedIndustrial)})
+	key, err := bc.stub.CreateCompositeKey(prefix, []string{balanceLock.Id})
+	if err != nil {
+		return fmt.Errorf("create key: %w", err)
+	}
+
+	data, err := json.Marshal(balanceLock)
+	if err != nil {
+		return fmt.Errorf("json marshal: %w", err)
+	}
+
+	balanceLockExecutedEvent := &proto.TokenBalanceLockExecuted{
+		Id:                balanceLock.Id,
+		Address:           balanceLock.Address,
+		Token:             balanceLock.Token,
+		Amount:            amount.String(),
+		Beneficiary:       beneficiary.String(),
+		Reason:            balanceLock.Reason,
+		Docs:              balanceLock.Docs,
+		Payload:           balanceLock.Payload,
+		CompleteOperation: isDelete,
+	}
+	event, err := json.Marshal(balanceLockExecutedEvent)
+	if err != nil {
+		return err
+	}
+
+	if err = bc.stub.SetEvent(BalanceIndustrialLockExecutedEvent, event); err != nil {
+		return err
+	}
+
+	if isDelete {
+		if err = bc.unindexLock(LockKindIndustrial, balanceLock); err != nil {
+			return err
+		}
+		return bc.stub.DelState(key)
+	}
+	return bc.stub.PutState(key, data)
+}
@@ -32,6 +32,18 @@ var (
 	ErrAlredyExist = errors.New("lock alredy exist")
 	// ErrInsufficientFunds - error on insufficient funds
 	ErrInsufficientFunds = errors.New("insufficient funds to process")
+	// ErrLockExpiresAt - error on lock expiry in the past
+	ErrLockExpiresAt = errors.New("lock expiry should be in the future")
+	// ErrLockExpired - error on execution of an expired lock
+	ErrLockExpired = errors.New("lock expired")
+	// ErrLockNotExpired - error on release of a lock which hasn't expired
+	ErrLockNotExpired = errors.New("lock hasn't expired")
+	// ErrBeneficiaryRequired - error on execution of a lock without beneficiary
+	ErrBeneficiaryRequired = errors.New("beneficiary required")
+	// ErrLockAmountNotPositive - error on execution of a lock with zero or negative amount
+	ErrLockAmountNotPositive = errors.New("lock amount should be positive")
+	// ErrLockMismatch - error on execution of a lock with the address or the token other than ones of the lock
+	ErrLockMismatch = errors.New("address or token of the request doesn't match the lock")
 )
 
 const (
@@ -43,6 +55,10 @@ const (
 	BalanceAllowedLockedEvent = "BalanceAllowedLocked"
 	// BalanceAllowedUnlockedEvent - event on allowed balance unlocked
 	BalanceAllowedUnlockedEvent = "BalanceAllowedUnlocked"
+	// BalanceTokenLockExecutedEvent - event on locked token balance transferred to the beneficiary
+	BalanceTokenLockExecutedEvent = "BalanceTokenLockExecuted"
+	// BalanceAllowedLockExecutedEvent - event on locked allowed balance transferred to the beneficiary
+	BalanceAllowedLockExecutedEvent = "BalanceAllowedLockExecuted"
 )
 
 // TxLockTokenBalance - blocks tokens on the user's token balance
@@ -60,6 +76,10 @@ func (bc *BaseContract) TxLockTokenBalance( //nolint:funlen
 		return err
 	}
 
+	if err = bc.verifyLockTerms(req); err != nil {
+		return err
+	}
+
 	// Check what's already there
 	_, err = bc.getLockedTokenBalance(req.Id)
 	if err == nil {
@@ -90,6 +110,9 @@ func (bc *BaseContract) TxLockTokenBalance( //nolint:funlen
 		Reason:        req.Reason,
 		Docs:          req.Docs,
 		Payload:       req.Payload,
+		ExpiresAt:     req.ExpiresAt,
+		Beneficiary:   req.Beneficiary,
+		Tag:           req.Tag,
 	}
 
 	prefix := hex.EncodeToString([]byte{byte(StateKeyExternalLockedToken)})
@@ -104,13 +127,15 @@ func (bc *BaseContract) TxLockTokenBalance( //nolint:funlen
 	}
 
 	balanceLockedEvent := &proto.TokenBalanceLocked{
-		Id:      balanceLock.Id,
-		Address: balanceLock.Address,
-		Token:   balanceLock.Token,
-		Amount:  balanceLock.CurrentAmount,
-		Reason:  balanceLock.Reason,
-		Docs:    balanceLock.Docs,
-		Payload: balanceLock.Payload,
+		Id:          balanceLock.Id,
+		Address:     balanceLock.Address,
+		Token:       balanceLock.Token,
+		Amount:      balanceLock.CurrentAmount,
+		Reason:      balanceLock.Reason,
+		Docs:        balanceLock.Docs,
+		Payload:     balanceLock.Payload,
+		ExpiresAt:   balanceLock.ExpiresAt,
+		Beneficiary: balanceLock.Beneficiary,
 	}
 	event, err := json.Marshal(balanceLockedEvent)
 	if err != nil {
@@ -121,6 +146,10 @@ func (bc *BaseContract) TxLockTokenBalance( //nolint:funlen
 		return err
 	}
 
+	if err = bc.indexLock(LockKindToken, balanceLock); err != nil {
+		return err
+	}
+
 	return bc.stub.PutState(key, data)
 }
 
@@ -151,6 +180,11 @@ func (bc *BaseContract) TxUnlockTokenBalance( //nolint:funlen
 		return ErrBigIntFromString
 	}
 
+	return bc.unlockTokenBalance(balanceLock, address, amount)
+}
+
+// unlockTokenBalance unlocks the amount of the lock and deletes the lock if it's fully unlocked
+func (bc *BaseContract) unlockTokenBalance(balanceLock *proto.TokenBalanceLock, address *types.Address, amount *big.Int) error {
 	cur, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
 	if !ok {
 		return ErrBigIntFromString
@@ -165,7 +199,7 @@ func (bc *BaseContract) TxUnlockTokenBalance( //nolint:funlen
 		isDelete = true
 	}
 
-	if err = bc.TokenBalanceUnlock(address, amount); err != nil {
+	if err := bc.TokenBalanceUnlock(address, amount); err != nil {
 		return err
 	}
 
@@ -203,6 +237,9 @@ func (bc *BaseContract) TxUnlockTokenBalance( //nolint:funlen
 	}
 
 	if isDelete {
+		if err = bc.unindexLock(LockKindToken, balanceLock); err != nil {
+			return err
+		}
 		return bc.stub.DelState(key)
 	}
 	return bc.stub.PutState(key, data)
@@ -230,6 +267,10 @@ func (bc *BaseContract) TxLockAllowedBalance( //nolint:funlen
 		return err
 	}
 
+	if err = bc.verifyLockTerms(req); err != nil {
+		return err
+	}
+
 	// Check what's already there
 	_, err = bc.getLockedAllowedBalance(req.Id)
 	if err == nil {
@@ -260,6 +301,9 @@ func (bc *BaseContract) TxLockAllowedBalance( //nolint:funlen
 		Reason:        req.Reason,
 		Docs:          req.Docs,
 		Payload:       req.Payload,
+		ExpiresAt:     req.ExpiresAt,
+		Beneficiary:   req.Beneficiary,
+		Tag:           req.Tag,
 	}
 
 	prefix := hex.EncodeToString([]byte{byte(StateKeyExternalLockedAllowed)})
@@ -274,13 +318,15 @@ func (bc *BaseContract) TxLockAllowedBalance( //nolint:funlen
 	}
 
 	balanceLockedEvent := &proto.AllowedBalanceLocked{
-		Id:      balanceLock.Id,
-		Address: balanceLock.Address,
-		Token:   balanceLock.Token,
-		Amount:  balanceLock.CurrentAmount,
-		Reason:  balanceLock.Reason,
-		Docs:    balanceLock.Docs,
-		Payload: balanceLock.Payload,
+		Id:          balanceLock.Id,
+		Address:     balanceLock.Address,
+		Token:       balanceLock.Token,
+		Amount:      balanceLock.CurrentAmount,
+		Reason:      balanceLock.Reason,
+		Docs:        balanceLock.Docs,
+		Payload:     balanceLock.Payload,
+		ExpiresAt:   balanceLock.ExpiresAt,
+		Beneficiary: balanceLock.Beneficiary,
 	}
 	event, err := json.Marshal(balanceLockedEvent)
 	if err != nil {
@@ -291,6 +337,10 @@ func (bc *BaseContract) TxLockAllowedBalance( //nolint:funlen
 		return err
 	}
 
+	if err = bc.indexLock(LockKindAllowed, balanceLock); err != nil {
+		return err
+	}
+
 	return bc.stub.PutState(key, data)
 }
 
@@ -321,6 +371,11 @@ func (bc *BaseContract) TxUnlockAllowedBalance( //nolint:funlen
 		return ErrBigIntFromString
 	}
 
+	return bc.unlockAllowedBalance(balanceLock, address, amount)
+}
+
+// unlockAllowedBalance unlocks the amount of the lock and deletes the lock if it's fully unlocked
+func (bc *BaseContract) unlockAllowedBalance(balanceLock *proto.AllowedBalanceLock, address *types.Address, amount *big.Int) error {
 	cur, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
 	if !ok {
 		return ErrBigIntFromString
@@ -335,7 +390,7 @@ func (bc *BaseContract) TxUnlockAllowedBalance( //nolint:funlen
 		isDelete = true
 	}
 
-	if err = bc.AllowedBalanceUnLock(balanceLock.Token, address, amount); err != nil {
+	if err := bc.AllowedBalanceUnLock(balanceLock.Token, address, amount); err != nil {
 		return err
 	}
 
@@ -373,6 +428,9 @@ func (bc *BaseContract) TxUnlockAllowedBalance( //nolint:funlen
 	}
 
 	if isDelete {
+		if err = bc.unindexLock(LockKindAllowed, balanceLock); err != nil {
+			return err
+		}
 		return bc.stub.DelState(key)
 	}
 	return bc.stub.PutState(key, data)
@@ -444,20 +502,10 @@ func (bc *BaseContract) verifyLockedArgs(
 	req *proto.BalanceLockRequest,
 ) error {
 	// Sender verification
-	l := bc.GetInitArgsLen()
-	if l < 1 {
-		return ErrPlatformAdminOnly
-	}
-
-	admin, err := types.AddrFromBase58Check(bc.GetInitArg(argPositionAdmin))
-	if err != nil {
+	if err := bc.checkAdmin(sender, ErrPlatformAdminOnly); err != nil {
 		return err
 	}
 
-	if !sender.Equal(admin) {
-		return ErrPlatformAdminOnly
-	}
-
 	// Request verification
 	if req.Id == "" {
 		return ErrEmptyLockID
@@ -481,3 +529,340 @@ func (bc *BaseContract) verifyLockedArgs(
 
 	return nil
 }
+
+// verifyLockTerms checks the optional expiry and beneficiary of the lock request
+func (bc *BaseContract) verifyLockTerms(req *proto.BalanceLockRequest) error {
+	if req.ExpiresAt != 0 {
+		expired, err := bc.lockExpired(req.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		if expired {
+			return ErrLockExpiresAt
+		}
+	}
+	if req.Beneficiary != "" {
+		if _, err := types.AddrFromBase58Check(req.Beneficiary); err != nil {
+			return fmt.Errorf("beneficiary: %w", err)
+		}
+	}
+	return nil
+}
+
+// lockExpired returns true if the lock with the expiry has expired by the time of the transaction
+func (bc *BaseContract) lockExpired(expiresAt int64) (bool, error) {
+	if expiresAt == 0 {
+		return false, nil
+	}
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return false, err
+	}
+	return ts.Seconds >= expiresAt, nil
+}
+
+// verifyExecutedLock checks that the request executes the lock of the address and the token
+func verifyExecutedLock(req *proto.BalanceLockRequest, address string, token string) error {
+	if req.Address != address || req.Token != token {
+		return ErrLockMismatch
+	}
+	return nil
+}
+
+// lockBeneficiary returns the beneficiary of the lock or of the request if the lock doesn't have one
+func lockBeneficiary(lockBeneficiary string, req *proto.BalanceLockRequest) (*types.Address, error) {
+	beneficiary := lockBeneficiary
+	if beneficiary == "" {
+		beneficiary = req.Beneficiary
+	}
+	if beneficiary == "" {
+		return nil, ErrBeneficiaryRequired
+	}
+	addr, err := types.AddrFromBase58Check(beneficiary)
+	if err != nil {
+		return nil, fmt.Errorf("beneficiary: %w", err)
+	}
+	return addr, nil
+}
+
+// NBTxReleaseExpiredTokenBalanceLock - returns tokens of the expired lock to the owner's token balance,
+// method can be called by anyone (e.g. a robot) after the lock expired
+func (bc *BaseContract) NBTxReleaseExpiredTokenBalanceLock(lockID string) error {
+	balanceLock, err := bc.getLockedTokenBalance(lockID)
+	if err != nil {
+		return err
+	}
+
+	expired, err := bc.lockExpired(balanceLock.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	if !expired {
+		return ErrLockNotExpired
+	}
+
+	address, err := types.AddrFromBase58Check(balanceLock.Address)
+	if err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+
+	amount, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	return bc.unlockTokenBalance(balanceLock, address, amount)
+}
+
+// NBTxReleaseExpiredAllowedBalanceLock - returns tokens of the expired lock to the owner's allowed balance,
+// method can be called by anyone (e.g. a robot) after the lock expired
+func (bc *BaseContract) NBTxReleaseExpiredAllowedBalanceLock(lockID string) error {
+	balanceLock, err := bc.getLockedAllowedBalance(lockID)
+	if err != nil {
+		return err
+	}
+
+	expired, err := bc.lockExpired(balanceLock.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	if !expired {
+		return ErrLockNotExpired
+	}
+
+	address, err := types.AddrFromBase58Check(balanceLock.Address)
+	if err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+
+	amount, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	return bc.unlockAllowedBalance(balanceLock, address, amount)
+}
+
+// TxExecuteTokenBalanceLock - transfers (fully or partially) locked tokens to the beneficiary of the lock
+// or to the beneficiary of the request if the lock doesn't have one.
+// method is called by the chaincode admin, the input is BalanceLockRequest
+func (bc *BaseContract) TxExecuteTokenBalanceLock( //nolint:funlen
+	sender *types.Sender,
+	req *proto.BalanceLockRequest,
+) error {
+	err := bc.verifyLockedArgs(sender, req)
+	if err != nil {
+		return err
+	}
+
+	balanceLock, err := bc.getLockedTokenBalance(req.Id)
+	if err != nil {
+		return err
+	}
+
+	expired, err := bc.lockExpired(balanceLock.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	if expired {
+		return ErrLockExpired
+	}
+
+	if err = verifyExecutedLock(req, balanceLock.Address, balanceLock.Token); err != nil {
+		return err
+	}
+
+	beneficiary, err := lockBeneficiary(balanceLock.Beneficiary, req)
+	if err != nil {
+		return err
+	}
+
+	address, err := types.AddrFromBase58Check(balanceLock.Address)
+	if err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+
+	amount, ok := new(big.Int).SetString(req.Amount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+	if amount.Sign() <= 0 {
+		return ErrLockAmountNotPositive
+	}
+
+	cur, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	isDelete := false
+	c := cur.Cmp(amount)
+	switch {
+	case c < 0:
+		return ErrInsufficientFunds
+	case c == 0:
+		isDelete = true
+	}
+
+	// the admin executes locks of frozen owners, only the beneficiary is checked
+	if err = checkFrozen(bc.stub, nil, beneficiary); err != nil {
+		return err
+	}
+	if err = bc.tokenBalanceTransferLocked(address, beneficiary, amount, balanceLock.Reason); err != nil {
+		return err
+	}
+
+	// state record with balance lock details
+	balanceLock.CurrentAmount = new(big.Int).Sub(cur, amount).String()
+
+	prefix := hex.EncodeToString([]byte{byte(StateKeyExternalLockedToken)})
+	key, err := bc.stub.CreateCompositeKey(prefix, []string{balanceLock.Id})
+	if err != nil {
+		return fmt.Errorf("create key: %w", err)
+	}
+
+	data, err := json.Marshal(balanceLock)
+	if err != nil {
+		return fmt.Errorf("json marshal: %w", err)
+	}
+
+	balanceLockExecutedEvent := &proto.TokenBalanceLockExecuted{
+		Id:                balanceLock.Id,
+		Address:           balanceLock.Address,
+		Token:             balanceLock.Token,
+		Amount:            amount.String(),
+		Beneficiary:       beneficiary.String(),
+		Reason:            balanceLock.Reason,
+		Docs:              balanceLock.Docs,
+		Payload:           balanceLock.Payload,
+		CompleteOperation: isDelete,
+	}
+	event, err := json.Marshal(balanceLockExecutedEvent)
+	if err != nil {
+		return err
+	}
+
+	if err = bc.stub.SetEvent(BalanceTokenLockExecutedEvent, event); err != nil {
+		return err
+	}
+
+	if isDelete {
+		if err = bc.unindexLock(LockKindToken, balanceLock); err != nil {
+			return err
+		}
+		return bc.stub.DelState(key)
+	}
+	return bc.stub.PutState(key, data)
+}
+
+// TxExecuteAllowedBalanceLock - transfers (fully or partially) locked allowed tokens to the beneficiary of the lock
+// or to the beneficiary of the request if the lock doesn't have one.
+// method is called by the chaincode admin, the input is BalanceLockRequest
+func (bc *BaseContract) TxExecuteAllowedBalanceLock( //nolint:funlen
+	sender *types.Sender,
+	req *proto.BalanceLockRequest,
+) error {
+	err := bc.verifyLockedArgs(sender, req)
+	if err != nil {
+		return err
+	}
+
+	balanceLock, err := bc.getLockedAllowedBalance(req.Id)
+	if err != nil {
+		return err
+	}
+
+	expired, err := bc.lockExpired(balanceLock.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	if expired {
+		return ErrLockExpired
+	}
+
+	if err = verifyExecutedLock(req, balanceLock.Address, balanceLock.Token); err != nil {
+		return err
+	}
+
+	beneficiary, err := lockBeneficiary(balanceLock.Beneficiary, req)
+	if err != nil {
+		return err
+	}
+
+	address, err := types.AddrFromBase58Check(balanceLock.Address)
+	if err != nil {
+		return fmt.Errorf("address: %w", err)
+	}
+
+	amount, ok := new(big.Int).SetString(req.Amount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+	if amount.Sign() <= 0 {
+		return ErrLockAmountNotPositive
+	}
+
+	cur, ok := new(big.Int).SetString(balanceLock.CurrentAmount, 10) //nolint:gomnd
+	if !ok {
+		return ErrBigIntFromString
+	}
+
+	isDelete := false
+	c := cur.Cmp(amount)
+	switch {
+	case c < 0:
+		return ErrInsufficientFunds
+	case c == 0:
+		isDelete = true
+	}
+
+	// the admin executes locks of frozen owners, only the beneficiary is checked
+	if err = checkFrozen(bc.stub, nil, beneficiary); err != nil {
+		return err
+	}
+	if err = bc.allowedBalanceTransferLocked(balanceLock.Token, address, beneficiary, amount, balanceLock.Reason); err != nil {
+		return err
+	}
+
+	// state record with balance lock details
+	balanceLock.CurrentAmount = new(big.Int).Sub(cur, amount).String()
+
+	prefix := hex.EncodeToString([]byte{byte(StateKeyExternalLockedAllowed)})
+	key, err := bc.stub.CreateCompositeKey(prefix, []string{balanceLock.Id})
+	if err != nil {
+		return fmt.Errorf("create key: %w", err)
+	}
+
+	data, err := json.Marshal(balanceLock)
+	if err != nil {
+		return fmt.Errorf("json marshal: %w", err)
+	}
+
+	balanceLockExecutedEvent := &proto.AllowedBalanceLockExecuted{
+		Id:                balanceLock.Id,
+		Address:           balanceLock.Address,
+		Token:             balanceLock.Token,
+		Amount:            amount.String(),
+		Beneficiary:       beneficiary.String(),
+		Reason:            balanceLock.Reason,
+		Docs:              balanceLock.Docs,
+		Payload:           balanceLock.Payload,
+		CompleteOperation: isDelete,
+	}
+	event, err := json.Marshal(balanceLockExecutedEvent)
+	if err != nil {
+		return err
+	}
+
+	if err = bc.stub.SetEvent(BalanceAllowedLockExecutedEvent, event); err != nil {
+		return err
+	}
+
+	if isDelete {
+		if err = bc.unindexLock(LockKindAllowed, balanceLock); err != nil {
+			return err
+		}
+		return bc.stub.DelState(key)
+	}
+	return bc.stub.PutState(key, data)
+}
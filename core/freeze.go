@@ -0,0 +1,239 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+// freeze modes
+const (
+	FreezeOutgoing = "outgoing"
+	FreezeIncoming = "incoming"
+	FreezeFull     = "full"
+)
+
+const (
+	freezeKey            = "freeze"
+	complianceOfficerKey = "complianceOfficer"
+
+	// AddressFrozenEvent - event on address frozen or its freeze mode changed
+	AddressFrozenEvent = "AddressFrozen"
+	// AddressUnfrozenEvent - event on address unfrozen
+	AddressUnfrozenEvent = "AddressUnfrozen"
+
+	maxFreezePageSize = 1000
+)
+
+// freeze errors
+var (
+	ErrFreezeAdminOnly = errors.New("freeze is managed by the admin or the compliance officer")
+	ErrFreezeMode      = errors.New("freeze mode must be outgoing, incoming or full")
+	ErrAddressFrozen   = errors.New("address is frozen")
+	ErrNotFrozen       = errors.New("address isn't frozen")
+	ErrFreezePageSize  = errors.New("page size must be from 1 to 1000")
+	ErrFreezeBookmark  = errors.New("invalid bookmark")
+)
+
+// FrozenAddress is a record of the freeze registry
+type FrozenAddress struct {
+	Address   string `json:"address"`
+	Mode      string `json:"mode"`
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// FrozenAddresses is a page of frozen addresses
+type FrozenAddresses struct {
+	Addresses []*FrozenAddress `json:"addresses"`
+	Bookmark  string           `json:"bookmark"`
+}
+
+func getFreeze(stub shim.ChaincodeStubInterface, address string) (*FrozenAddress, error) {
+	key, err := stub.CreateCompositeKey(freezeKey, []string{address})
+	if err != nil {
+		return nil, err
+	}
+	data, err := stub.GetState(key)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	frozen := &FrozenAddress{}
+	if err = json.Unmarshal(data, frozen); err != nil {
+		return nil, err
+	}
+	return frozen, nil
+}
+
+// checkFrozen returns an error if funds can't leave the from address or can't come to the to address.
+// Empty addresses (emission and burning) aren't checked
+func checkFrozen(stub shim.ChaincodeStubInterface, from, to *types.Address) error {
+	if !isEmptyAddress(from) {
+		frozen, err := getFreeze(stub, from.String())
+		if err != nil {
+			return err
+		}
+		if frozen != nil && frozen.Mode != FreezeIncoming {
+			return fmt.Errorf("%w: outgoing transfers of %s", ErrAddressFrozen, from.String())
+		}
+	}
+	if !isEmptyAddress(to) {
+		frozen, err := getFreeze(stub, to.String())
+		if err != nil {
+			return err
+		}
+		if frozen != nil && frozen.Mode != FreezeOutgoing {
+			return fmt.Errorf("%w: incoming transfers of %s", ErrAddressFrozen, to.String())
+		}
+	}
+	return nil
+}
+
+// checkFreezeManager checks that the sender is the admin or the compliance officer
+func (bc *BaseContract) checkFreezeManager(sender *types.Sender) error {
+	if err := bc.checkAdmin(sender, ErrFreezeAdminOnly); err == nil {
+		return nil
+	}
+	officer, err := bc.stub.GetState(complianceOfficerKey)
+	if err != nil {
+		return err
+	}
+	if len(officer) == 0 || sender.Address().String() != string(officer) {
+		return ErrFreezeAdminOnly
+	}
+	return nil
+}
+
+// TxSetComplianceOfficer sets the address which can freeze and unfreeze addresses besides the admin,
+// method is called by the chaincode admin
+func (bc *BaseContract) TxSetComplianceOfficer(sender *types.Sender, address *types.Address) error {
+	if err := bc.checkAdmin(sender, ErrFreezeAdminOnly); err != nil {
+		return err
+	}
+	return bc.stub.PutState(complianceOfficerKey, []byte(address.String()))
+}
+
+// QueryComplianceOfficer returns the compliance officer, empty if it isn't set
+func (bc *BaseContract) QueryComplianceOfficer() (string, error) {
+	officer, err := bc.stub.GetState(complianceOfficerKey)
+	return string(officer), err
+}
+
+// TxFreezeAddress freezes outgoing, incoming or all transfers of the address in this token,
+// method is called by the admin or the compliance officer
+func (bc *BaseContract) TxFreezeAddress(sender *types.Sender, address *types.Address, mode string, reason string) error {
+	if err := bc.checkFreezeManager(sender); err != nil {
+		return err
+	}
+	if mode != FreezeOutgoing && mode != FreezeIncoming && mode != FreezeFull {
+		return ErrFreezeMode
+	}
+	if reason == "" {
+		return ErrReason
+	}
+
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+	frozen := &FrozenAddress{Address: address.String(), Mode: mode, Reason: reason, Timestamp: ts.Seconds}
+	data, err := json.Marshal(frozen)
+	if err != nil {
+		return err
+	}
+	key, err := bc.stub.CreateCompositeKey(freezeKey, []string{frozen.Address})
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.SetEvent(AddressFrozenEvent, data); err != nil {
+		return err
+	}
+	return bc.stub.PutState(key, data)
+}
+
+// TxUnfreezeAddress removes the address from the freeze registry,
+// method is called by the admin or the compliance officer
+func (bc *BaseContract) TxUnfreezeAddress(sender *types.Sender, address *types.Address, reason string) error {
+	if err := bc.checkFreezeManager(sender); err != nil {
+		return err
+	}
+	if reason == "" {
+		return ErrReason
+	}
+
+	frozen, err := getFreeze(bc.stub, address.String())
+	if err != nil {
+		return err
+	}
+	if frozen == nil {
+		return ErrNotFrozen
+	}
+	frozen.Reason = reason
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+	frozen.Timestamp = ts.Seconds
+	data, err := json.Marshal(frozen)
+	if err != nil {
+		return err
+	}
+	key, err := bc.stub.CreateCompositeKey(freezeKey, []string{frozen.Address})
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.SetEvent(AddressUnfrozenEvent, data); err != nil {
+		return err
+	}
+	return bc.stub.DelState(key)
+}
+
+// QueryFreezeStatus returns the freeze record of the address, null if the address isn't frozen
+func (bc *BaseContract) QueryFreezeStatus(address *types.Address) (*FrozenAddress, error) {
+	return getFreeze(bc.stub, address.String())
+}
+
+// QueryFrozenAddresses returns a page of frozen addresses
+func (bc *BaseContract) QueryFrozenAddresses(pageSize int64, bookmark string) (*FrozenAddresses, error) {
+	if pageSize < 1 || pageSize > maxFreezePageSize {
+		return nil, ErrFreezePageSize
+	}
+	if bookmark != "" {
+		prefix, err := bc.stub.CreateCompositeKey(freezeKey, []string{})
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(bookmark, prefix) {
+			return nil, ErrFreezeBookmark
+		}
+	}
+
+	iter, meta, err := bc.stub.GetStateByPartialCompositeKeyWithPagination(freezeKey, []string{}, int32(pageSize), bookmark)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	result := &FrozenAddresses{Addresses: []*FrozenAddress{}}
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		frozen := &FrozenAddress{}
+		if err = json.Unmarshal(kv.Value, frozen); err != nil {
+			return nil, err
+		}
+		result.Addresses = append(result.Addresses, frozen)
+	}
+	if meta != nil {
+		result.Bookmark = meta.Bookmark
+	}
+	return result, nil
+}
@@ -2,14 +2,11 @@ package helpers
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
-	"net/http"
 	"strings"
 
 	pb "github.com/atomyze-foundation/foundation/proto"
 	"github.com/btcsuite/btcutil/base58"
-	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 )
 
@@ -129,67 +126,15 @@ func CheckACL(stub shim.ChaincodeStubInterface, keys []string) (*pb.AclResponse,
 
 // GetAddress returns pb.AclResponse from the ACL
 func GetAddress(stub shim.ChaincodeStubInterface, keys string) (*pb.AclResponse, error) {
-	resp := stub.InvokeChaincode("acl", [][]byte{
-		[]byte("checkKeys"),
-		[]byte(keys),
-	}, "acl")
-
-	if resp.Status != http.StatusOK {
-		return nil, errors.New(resp.Message)
-	}
-
-	if len(resp.Payload) == 0 {
-		return nil, errors.New("empty response")
-	}
-
-	addrMsg := &pb.AclResponse{}
-	if err := proto.Unmarshal(resp.Payload, addrMsg); err != nil {
-		return nil, err
-	}
-
-	return addrMsg, nil
+	return ACL(stub).CheckKeys(stub, keys)
 }
 
 // GetFullAddress returns pb.Address from the ACL
 func GetFullAddress(stub shim.ChaincodeStubInterface, key string) (*pb.Address, error) {
-	resp := stub.InvokeChaincode("acl", [][]byte{
-		[]byte("checkAddress"),
-		[]byte(key),
-	}, "acl")
-
-	if resp.Status != http.StatusOK {
-		return nil, errors.New(resp.Message)
-	}
-
-	if len(resp.Payload) == 0 {
-		return nil, errors.New("empty response")
-	}
-
-	addrMsg := &pb.Address{}
-	if err := proto.Unmarshal(resp.Payload, addrMsg); err != nil {
-		return nil, err
-	}
-
-	return addrMsg, nil
+	return ACL(stub).CheckAddress(stub, key)
 }
 
 // GetAccountInfo returns pb.AccountInfo from the ACL
 func GetAccountInfo(stub shim.ChaincodeStubInterface, addr string) (*pb.AccountInfo, error) {
-	resp := stub.InvokeChaincode("acl", [][]byte{
-		[]byte("getAccountInfo"),
-		[]byte(addr),
-	}, "acl")
-
-	if resp.Status != http.StatusOK {
-		return nil, errors.New(resp.Message)
-	}
-	if len(resp.Payload) == 0 {
-		return nil, errors.New("empty response")
-	}
-
-	infoMsg := pb.AccountInfo{}
-	if err := json.Unmarshal(resp.Payload, &infoMsg); err != nil {
-		return nil, err
-	}
-	return &infoMsg, nil
+	return ACL(stub).GetAccountInfo(stub, addr)
 }
@@ -0,0 +1,259 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"golang.org/x/crypto/sha3"
+)
+
+// DefaultACLChannel and DefaultACLChaincode are the names of the channel
+// and the chaincode where the ACL chaincode is usually deployed
+const (
+	DefaultACLChannel   = "acl"
+	DefaultACLChaincode = "acl"
+)
+
+const (
+	stateACLAddressPrefix     = "stateacladdr"
+	stateACLAccountInfoPrefix = "stateaclaccinfo"
+)
+
+// ACLProvider is a source of addresses and account information for the chaincode
+type ACLProvider interface {
+	// CheckKeys returns address and account information for the public keys joined by "/"
+	CheckKeys(stub shim.ChaincodeStubInterface, keys string) (*pb.AclResponse, error)
+	// CheckAddress returns full address by its base58check representation
+	CheckAddress(stub shim.ChaincodeStubInterface, addr string) (*pb.Address, error)
+	// GetAccountInfo returns account information by base58check address
+	GetAccountInfo(stub shim.ChaincodeStubInterface, addr string) (*pb.AccountInfo, error)
+}
+
+// ACLProviderHolder is implemented by stubs which carry the ACL provider of the chaincode
+type ACLProviderHolder interface {
+	ACLProvider() ACLProvider
+}
+
+var defaultACLProvider = NewChaincodeACLProvider(DefaultACLChannel, DefaultACLChaincode)
+
+// ACL returns the ACL provider carried by the stub or the default one,
+// which requests the "acl" chaincode in the "acl" channel
+func ACL(stub shim.ChaincodeStubInterface) ACLProvider {
+	if holder, ok := stub.(ACLProviderHolder); ok {
+		if provider := holder.ACLProvider(); provider != nil {
+			return provider
+		}
+	}
+	return defaultACLProvider
+}
+
+// ACLChaincode returns channel and chaincode names of the ACL chaincode used by the stub.
+// If the ACL isn't provided by a chaincode, default names are returned
+func ACLChaincode(stub shim.ChaincodeStubInterface) (string, string) {
+	if provider, ok := ACL(stub).(*ChaincodeACLProvider); ok {
+		return provider.Channel, provider.Chaincode
+	}
+	return DefaultACLChannel, DefaultACLChaincode
+}
+
+// ChaincodeACLProvider requests the ACL chaincode deployed in the Channel with the Chaincode name
+type ChaincodeACLProvider struct {
+	Channel   string
+	Chaincode string
+}
+
+// NewChaincodeACLProvider creates ACL provider for the ACL chaincode with the given names
+func NewChaincodeACLProvider(channel string, chaincode string) *ChaincodeACLProvider {
+	return &ChaincodeACLProvider{
+		Channel:   channel,
+		Chaincode: chaincode,
+	}
+}
+
+func (p *ChaincodeACLProvider) invoke(stub shim.ChaincodeStubInterface, fn string, arg string) ([]byte, error) {
+	resp := stub.InvokeChaincode(p.Chaincode, [][]byte{
+		[]byte(fn),
+		[]byte(arg),
+	}, p.Channel)
+
+	if resp.Status != http.StatusOK {
+		return nil, errors.New(resp.Message)
+	}
+
+	if len(resp.Payload) == 0 {
+		return nil, errors.New("empty response")
+	}
+
+	return resp.Payload, nil
+}
+
+// CheckKeys returns pb.AclResponse from the ACL chaincode
+func (p *ChaincodeACLProvider) CheckKeys(stub shim.ChaincodeStubInterface, keys string) (*pb.AclResponse, error) {
+	payload, err := p.invoke(stub, "checkKeys", keys)
+	if err != nil {
+		return nil, err
+	}
+
+	addrMsg := &pb.AclResponse{}
+	if err = proto.Unmarshal(payload, addrMsg); err != nil {
+		return nil, err
+	}
+
+	return addrMsg, nil
+}
+
+// CheckAddress returns pb.Address from the ACL chaincode
+func (p *ChaincodeACLProvider) CheckAddress(stub shim.ChaincodeStubInterface, addr string) (*pb.Address, error) {
+	payload, err := p.invoke(stub, "checkAddress", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	addrMsg := &pb.Address{}
+	if err = proto.Unmarshal(payload, addrMsg); err != nil {
+		return nil, err
+	}
+
+	return addrMsg, nil
+}
+
+// GetAccountInfo returns pb.AccountInfo from the ACL chaincode
+func (p *ChaincodeACLProvider) GetAccountInfo(stub shim.ChaincodeStubInterface, addr string) (*pb.AccountInfo, error) {
+	payload, err := p.invoke(stub, "getAccountInfo", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	infoMsg := pb.AccountInfo{}
+	if err = json.Unmarshal(payload, &infoMsg); err != nil {
+		return nil, err
+	}
+	return &infoMsg, nil
+}
+
+// StateACLProvider keeps the ACL in the state of the chaincode itself.
+// It is intended for standalone deployments and tests: addresses are derived from public keys
+// the same way the ACL chaincode does it, address details and account information
+// can be stored with PutStateACLAddress and PutStateACLAccountInfo
+type StateACLProvider struct{}
+
+// CheckKeys derives address from the public keys and returns it with the stored account information
+func (p *StateACLProvider) CheckKeys(stub shim.ChaincodeStubInterface, keys string) (*pb.AclResponse, error) {
+	pubKeys := strings.Split(keys, "/")
+	binPubKeys := make([][]byte, len(pubKeys))
+	for i, k := range pubKeys {
+		binPubKeys[i] = base58.Decode(k)
+		if len(binPubKeys[i]) == 0 {
+			return nil, errors.New("incorrect public key")
+		}
+	}
+	sort.Slice(binPubKeys, func(i, j int) bool {
+		return bytes.Compare(binPubKeys[i], binPubKeys[j]) < 0
+	})
+	hashed := sha3.Sum256(bytes.Join(binPubKeys, []byte("")))
+
+	addr, err := p.CheckAddress(stub, base58.CheckEncode(hashed[1:], hashed[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	signedAddr := &pb.SignedAddress{Address: addr}
+	if len(binPubKeys) > 1 {
+		addr.IsMultisig = true
+		signedAddr.SignaturePolicy = &pb.SignaturePolicy{
+			N:       uint32(len(binPubKeys)),
+			PubKeys: binPubKeys,
+		}
+	}
+
+	info, err := p.GetAccountInfo(stub, addr.AddrString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AclResponse{
+		Account: info,
+		Address: signedAddr,
+	}, nil
+}
+
+// CheckAddress returns stored address details or bare address if nothing is stored
+func (p *StateACLProvider) CheckAddress(stub shim.ChaincodeStubInterface, addr string) (*pb.Address, error) {
+	value, ver, err := base58.CheckDecode(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := getStateACLRecord(stub, stateACLAddressPrefix, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	addrMsg := &pb.Address{}
+	if len(data) != 0 {
+		if err = proto.Unmarshal(data, addrMsg); err != nil {
+			return nil, err
+		}
+	}
+	addrMsg.Address = append([]byte{ver}, value...)
+
+	return addrMsg, nil
+}
+
+// GetAccountInfo returns stored account information or empty one if nothing is stored
+func (p *StateACLProvider) GetAccountInfo(stub shim.ChaincodeStubInterface, addr string) (*pb.AccountInfo, error) {
+	data, err := getStateACLRecord(stub, stateACLAccountInfoPrefix, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	infoMsg := &pb.AccountInfo{}
+	if len(data) != 0 {
+		if err = proto.Unmarshal(data, infoMsg); err != nil {
+			return nil, err
+		}
+	}
+	return infoMsg, nil
+}
+
+// PutStateACLAddress stores address details (userID, industrial flag) for the StateACLProvider
+func PutStateACLAddress(stub shim.ChaincodeStubInterface, addr *pb.Address) error {
+	data, err := proto.Marshal(addr)
+	if err != nil {
+		return err
+	}
+	return putStateACLRecord(stub, stateACLAddressPrefix, addr.AddrString(), data)
+}
+
+// PutStateACLAccountInfo stores account information (KYC hash, gray and black lists) for the StateACLProvider
+func PutStateACLAccountInfo(stub shim.ChaincodeStubInterface, addr string, info *pb.AccountInfo) error {
+	data, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return putStateACLRecord(stub, stateACLAccountInfoPrefix, addr, data)
+}
+
+func getStateACLRecord(stub shim.ChaincodeStubInterface, prefix string, addr string) ([]byte, error) {
+	key, err := shim.CreateCompositeKey(prefix, []string{addr})
+	if err != nil {
+		return nil, err
+	}
+	return stub.GetState(key)
+}
+
+func putStateACLRecord(stub shim.ChaincodeStubInterface, prefix string, addr string, data []byte) error {
+	key, err := shim.CreateCompositeKey(prefix, []string{addr})
+	if err != nil {
+		return err
+	}
+	return stub.PutState(key, data)
+}
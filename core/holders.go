@@ -0,0 +1,159 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+// ErrHoldersBookmark is returned when the bookmark of the holders enumeration can't be decoded
+var ErrHoldersBookmark = errors.New("invalid holders bookmark")
+
+const (
+	// holders with token balance, their locked balance is returned too
+	holdersPhaseBalance = iota
+	// holders which have only locked token balance
+	holdersPhaseLocked
+	holdersPhaseDone
+)
+
+// TokenHolder is an address with its token balance and locked token balance
+type TokenHolder struct {
+	Address string   `json:"address"`
+	Balance *big.Int `json:"balance"`
+	Locked  *big.Int `json:"locked"`
+}
+
+// holdersCursor is the decoded bookmark of the holders enumeration
+type holdersCursor struct {
+	Phase    int    `json:"phase"`
+	Bookmark string `json:"bookmark"`
+}
+
+// TokenHolders returns a page of addresses which have token or locked token balance (industrial balances aren't included).
+// Every holder is returned once: first holders with token balance are enumerated, then holders
+// which have only locked balance. The page can contain fewer than pageSize holders.
+// The returned bookmark is used to get the next page, it's empty after the last page
+func (bc *BaseContract) TokenHolders(pageSize int32, bookmark string) ([]*TokenHolder, string, error) {
+	cursor := &holdersCursor{}
+	if bookmark != "" {
+		data, err := base64.StdEncoding.DecodeString(bookmark)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %s", ErrHoldersBookmark, err.Error())
+		}
+		if err = json.Unmarshal(data, cursor); err != nil {
+			return nil, "", fmt.Errorf("%w: %s", ErrHoldersBookmark, err.Error())
+		}
+		if cursor.Phase != holdersPhaseBalance && cursor.Phase != holdersPhaseLocked {
+			return nil, "", ErrHoldersBookmark
+		}
+	}
+
+	listed, other := StateKeyTokenBalance, StateKeyLockedTokenBalance
+	if cursor.Phase == holdersPhaseLocked {
+		listed, other = StateKeyLockedTokenBalance, StateKeyTokenBalance
+	}
+
+	iter, meta, err := bc.stub.GetStateByPartialCompositeKeyWithPagination(
+		hex.EncodeToString([]byte{byte(listed)}), []string{}, pageSize, cursor.Bookmark)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	var holders []*TokenHolder
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, "", err
+		}
+		holder, err := bc.tokenHolder(kv.Key, kv.Value, other, cursor.Phase)
+		if err != nil {
+			return nil, "", err
+		}
+		if holder != nil {
+			holders = append(holders, holder)
+		}
+	}
+
+	if meta == nil || meta.Bookmark == "" || meta.FetchedRecordsCount < pageSize {
+		cursor.Phase++
+		cursor.Bookmark = ""
+	} else {
+		cursor.Bookmark = meta.Bookmark
+	}
+	if cursor.Phase == holdersPhaseDone {
+		return holders, "", nil
+	}
+
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	return holders, base64.StdEncoding.EncodeToString(data), nil
+}
+
+// tokenHolder returns the holder of the balance key or nil if the key must be skipped
+func (bc *BaseContract) tokenHolder(key string, value []byte, other StateKey, phase int) (*TokenHolder, error) {
+	_, attrs, err := bc.stub.SplitCompositeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	// industrial balances have the group after the address
+	if len(attrs) != 1 || len(value) == 0 {
+		return nil, nil
+	}
+	addr, err := types.AddrFromBase58Check(attrs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	_, otherBalance, err := balanceGet(bc.stub, other, addr)
+	if err != nil {
+		return nil, err
+	}
+	if phase == holdersPhaseBalance {
+		return &TokenHolder{Address: attrs[0], Balance: new(big.Int).SetBytes(value), Locked: otherBalance}, nil
+	}
+	if otherBalance.Sign() != 0 {
+		// already returned with the token balance
+		return nil, nil
+	}
+	return &TokenHolder{Address: attrs[0], Balance: otherBalance, Locked: new(big.Int).SetBytes(value)}, nil
+}
+
+// GivenBalances returns given balances of all contracts
+func GivenBalances(stub shim.ChaincodeStubInterface) (map[string]*big.Int, error) {
+	iter, err := stub.GetStateByPartialCompositeKey(hex.EncodeToString([]byte{byte(StateKeyGivenBalance)}), []string{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	res := make(map[string]*big.Int)
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		_, attrs, err := stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		if len(attrs) != 1 {
+			return nil, fmt.Errorf("incorrect composite key %s (one-part key expected)", kv.Key)
+		}
+		res[attrs[0]] = new(big.Int).SetBytes(kv.Value)
+	}
+	return res, nil
+}
@@ -6,8 +6,11 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/atomyze-foundation/foundation/core/types"
 	"github.com/atomyze-foundation/foundation/proto"
 	pb "github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/hyperledger/fabric-chaincode-go/shim"
@@ -32,17 +35,75 @@ var (
 
 // Config is global chaincode parameters from Chaincode Init arguments
 type Config struct {
-	// AtomyzeSKI is 0 index from init args
+	// AtomyzeSKI is 0 index from init args or the atomyzeSKI field of the named config
 	AtomyzeSKI []byte
-	// RobotSKI is 1 index from init args
+	// RobotSKI is 1 index from init args or the robotSKI field of the named config
 	RobotSKI []byte
-	// Args is subarray from init args from 2 index to last index arg
+	// Args is subarray from init args from 2 index to last index arg,
+	// args of the named config are in the order of the init schema
 	Args []string
+	// Names are names of Args from the init schema
+	Names []string
+	// Options are named options of the named config declared by the init schema of the contract,
+	// they don't override core.ContractOptions
+	Options map[string]string
+}
+
+// Arg returns the init arg by its name in the init schema, empty if it isn't set
+func (c Config) Arg(name string) string {
+	for i, n := range c.Names {
+		if n == name && i < len(c.Args) {
+			return c.Args[i]
+		}
+	}
+	return ""
+}
+
+// Address returns the init arg with the address by its name
+func (c Config) Address(name string) (*types.Address, error) {
+	return types.AddrFromBase58Check(c.Arg(name))
+}
+
+// Uint returns the init arg with the number by its name
+func (c Config) Uint(name string) (uint64, error) {
+	return strconv.ParseUint(c.Arg(name), 10, 64) //nolint:gomnd
+}
+
+// Option returns the option by its name, empty if it isn't set
+func (c Config) Option(name string) string {
+	return c.Options[name]
+}
+
+// OptionUint returns the option with the number by its name, zero if it isn't set
+func (c Config) OptionUint(name string) (uint64, error) {
+	if c.Options[name] == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(c.Options[name], 10, 64) //nolint:gomnd
+}
+
+// OptionBool returns the option with the flag by its name, false if it isn't set
+func (c Config) OptionBool(name string) (bool, error) {
+	if c.Options[name] == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(c.Options[name])
 }
 
 // InitChaincode initializes the chaincode with provided arguments.
 // It validates the admin creator and stores necessary data (atomyzeSKI, robotSKI, initArgs) in the state.
 func InitChaincode(stub shim.ChaincodeStubInterface) error {
+	return InitChaincodeWithSchema(stub, nil)
+}
+
+// InitChaincodeWithSchema initializes the chaincode with positional args or with the single arg
+// with the named JSON config validated by the init schema of the contract:
+//
+//	{"atomyzeSKI":"...","robotSKI":"...","issuer":"...","options":{"...":"..."}}
+//
+// Positional args are checked against types of the schema, fields which aren't set aren't required
+// to keep compatibility with existing deployments.
+func InitChaincodeWithSchema(stub shim.ChaincodeStubInterface, schema *Schema) error {
 	if stub == nil {
 		return ErrNilStub
 	}
@@ -51,8 +112,22 @@ func InitChaincode(stub shim.ChaincodeStubInterface) error {
 	if err != nil {
 		return fmt.Errorf("failed to validate admin creator: %w", err)
 	}
+	if schema == nil {
+		schema = &Schema{}
+	}
 
 	args := stub.GetStringArgs()
+	if len(args) == 1 && strings.HasPrefix(strings.TrimSpace(args[0]), "{") {
+		config, err := parseNamedConfig(args[0], schema)
+		if err != nil {
+			return err
+		}
+		if err = saveInitArgs(stub, *config); err != nil {
+			return fmt.Errorf("failed to save InitArgs %v to statedb: %w", *config, err)
+		}
+		return nil
+	}
+
 	if len(args) < minChaincodeArgsCount {
 		return fmt.Errorf("should set SKI of atomyzeSKI and robotSKI certs. expected %d but found %d",
 			minChaincodeArgsCount,
@@ -68,10 +143,16 @@ func InitChaincode(stub shim.ChaincodeStubInterface) error {
 		return fmt.Errorf("failed to hex decode from string robotSKI %s: %w", args[1], err)
 	}
 
+	names, err := schema.validatePositional(args[2:])
+	if err != nil {
+		return err
+	}
+
 	initArgs := Config{
 		AtomyzeSKI: atomyzeSKI,
 		RobotSKI:   robotSKI,
 		Args:       args[2:],
+		Names:      names,
 	}
 	err = saveInitArgs(stub, initArgs)
 	if err != nil {
@@ -138,6 +219,11 @@ func LoadInitArgs(stub shim.ChaincodeStubInterface) (Config, error) {
 		AtomyzeSKI: initArgs.AtomyzeSKI,
 		RobotSKI:   initArgs.RobotSKI,
 		Args:       initArgs.Args,
+		Names:      initArgs.ArgNames,
+		Options:    make(map[string]string, len(initArgs.Options)),
+	}
+	for _, o := range initArgs.Options {
+		config.Options[o.Name] = o.Value
 	}
 
 	return config, nil
@@ -149,10 +235,23 @@ func saveInitArgs(stub shim.ChaincodeStubInterface, initArgs Config) error {
 		return ErrNilStub
 	}
 
+	// options are sorted to keep the state the same on all peers
+	names := make([]string, 0, len(initArgs.Options))
+	for name := range initArgs.Options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	options := make([]*proto.InitOption, 0, len(names))
+	for _, name := range names {
+		options = append(options, &proto.InitOption{Name: name, Value: initArgs.Options[name]})
+	}
+
 	initArgsBytes, err := pb.Marshal(&proto.InitArgs{
 		AtomyzeSKI: initArgs.AtomyzeSKI,
 		RobotSKI:   initArgs.RobotSKI,
 		Args:       initArgs.Args,
+		ArgNames:   initArgs.Names,
+		Options:    options,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to marshal InitArgs %v: %w", initArgs, err)
@@ -0,0 +1,190 @@
+package initialize
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+)
+
+// FieldType is the type of the value of the init config field
+type FieldType string
+
+// types of init config fields
+const (
+	FieldString  FieldType = "string"
+	FieldAddress FieldType = "address"
+	FieldHex     FieldType = "hex"
+	FieldUint    FieldType = "uint"
+	FieldBool    FieldType = "bool"
+)
+
+const (
+	configAtomyzeSKI = "atomyzeSKI"
+	configRobotSKI   = "robotSKI"
+	configOptions    = "options"
+)
+
+var (
+	// ErrConfigField is returned when the init config has an unknown, missing or malformed field.
+	ErrConfigField = errors.New("init config field")
+	// ErrNoSchema is returned when the named init config is passed to the contract without the schema.
+	ErrNoSchema = errors.New("contract has no init schema, use positional init args")
+)
+
+// Field is a named init arg of the contract
+type Field struct {
+	Name     string
+	Type     FieldType
+	Required bool
+}
+
+// Schema is init args of the contract in the order of their positions and named options
+type Schema struct {
+	Args    []Field
+	Options []Field
+}
+
+// validate checks that the value matches the type of the field
+func (f Field) validate(value string) error {
+	var err error
+	switch f.Type {
+	case FieldString:
+	case FieldAddress:
+		_, err = types.AddrFromBase58Check(value)
+	case FieldHex:
+		_, err = hex.DecodeString(value)
+	case FieldUint:
+		_, err = strconv.ParseUint(value, 10, 64) //nolint:gomnd
+	case FieldBool:
+		_, err = strconv.ParseBool(value)
+	default:
+		err = fmt.Errorf("unknown type %s", f.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("%w %s: %s isn't %s: %s", ErrConfigField, f.Name, value, f.Type, err.Error())
+	}
+	return nil
+}
+
+// validatePositional checks types of positional args which are set, extra args aren't checked
+func (s *Schema) validatePositional(args []string) ([]string, error) {
+	names := make([]string, 0, len(s.Args))
+	for i, f := range s.Args {
+		if i >= len(args) {
+			break
+		}
+		if args[i] != "" {
+			if err := f.validate(args[i]); err != nil {
+				return nil, fmt.Errorf("init arg %d: %w", i, err)
+			}
+		}
+		names = append(names, f.Name)
+	}
+	return names, nil
+}
+
+// parseNamedConfig parses the JSON init config with SKIs, named args of the schema and options
+func parseNamedConfig(raw string, schema *Schema) (*Config, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal init config: %w", err)
+	}
+	str := func(name string, value json.RawMessage) (string, error) {
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			return "", fmt.Errorf("%w %s: value must be a string", ErrConfigField, name)
+		}
+		return s, nil
+	}
+
+	config := &Config{Options: map[string]string{}}
+	for _, ski := range []struct {
+		name string
+		dst  *[]byte
+	}{{configAtomyzeSKI, &config.AtomyzeSKI}, {configRobotSKI, &config.RobotSKI}} {
+		value, ok := fields[ski.name]
+		if !ok {
+			return nil, fmt.Errorf("%w %s: required", ErrConfigField, ski.name)
+		}
+		delete(fields, ski.name)
+		s, err := str(ski.name, value)
+		if err != nil {
+			return nil, err
+		}
+		if *ski.dst, err = hex.DecodeString(s); err != nil {
+			return nil, fmt.Errorf("%w %s: %s", ErrConfigField, ski.name, err.Error())
+		}
+	}
+
+	options := map[string]json.RawMessage{}
+	if rawOptions, ok := fields[configOptions]; ok {
+		delete(fields, configOptions)
+		if err := json.Unmarshal(rawOptions, &options); err != nil || options == nil {
+			return nil, fmt.Errorf("%w %s: must be an object", ErrConfigField, configOptions)
+		}
+	}
+	for _, f := range schema.Options {
+		value, ok := options[f.Name]
+		if !ok {
+			if f.Required {
+				return nil, fmt.Errorf("%w %s: required option", ErrConfigField, f.Name)
+			}
+			continue
+		}
+		delete(options, f.Name)
+		s, err := str(f.Name, value)
+		if err != nil {
+			return nil, err
+		}
+		if err = f.validate(s); err != nil {
+			return nil, err
+		}
+		config.Options[f.Name] = s
+	}
+	if len(options) != 0 {
+		return nil, fmt.Errorf("%w %s: unknown option", ErrConfigField, firstKey(options))
+	}
+
+	if len(schema.Args) == 0 && len(fields) != 0 {
+		return nil, ErrNoSchema
+	}
+	for _, f := range schema.Args {
+		value, ok := fields[f.Name]
+		var s string
+		if ok {
+			delete(fields, f.Name)
+			var err error
+			if s, err = str(f.Name, value); err != nil {
+				return nil, err
+			}
+		}
+		if s == "" {
+			if f.Required {
+				return nil, fmt.Errorf("%w %s: required", ErrConfigField, f.Name)
+			}
+		} else if err := f.validate(s); err != nil {
+			return nil, err
+		}
+		config.Args = append(config.Args, s)
+		config.Names = append(config.Names, f.Name)
+	}
+	if len(fields) != 0 {
+		return nil, fmt.Errorf("%w %s: unknown field", ErrConfigField, firstKey(fields))
+	}
+	return config, nil
+}
+
+// firstKey returns the first key in the sorted order so that errors are the same on all peers
+func firstKey(m map[string]json.RawMessage) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys[0]
+}
@@ -0,0 +1,282 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+)
+
+const (
+	journalKey      = "journal"
+	journalTokenKey = "journalToken"
+	journalSeqKey   = "journalSeq"
+
+	// maxJournalPageSize limits the number of entries returned or deleted in one call
+	maxJournalPageSize = 1000
+)
+
+// journal errors
+var (
+	ErrJournalDisabled     = errors.New("journal is disabled")
+	ErrJournalAdminOnly    = errors.New("journal pruning is available only for the admin")
+	ErrJournalPageSize     = errors.New("page size must be from 1 to 1000")
+	ErrJournalNoRetention  = errors.New("journal retention isn't set")
+	ErrJournalBookmark     = errors.New("invalid bookmark")
+	ErrJournalSeqCorrupted = errors.New("journal sequence is corrupted")
+)
+
+// journalSettings enable the per-address journal. If retention isn't 0,
+// only the latest retention entries of every address are kept
+type journalSettings struct {
+	enabled   bool
+	retention uint64
+}
+
+// writeJournal writes both sides of the balance change to the journals of their addresses
+func (bc *BaseContract) writeJournal(change *pb.BalanceChange) error {
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+
+	if len(change.From) != 0 {
+		if err = bc.addJournalEntry(change.From, &pb.JournalEntry{
+			TxID:             bc.stub.GetTxID(),
+			Timestamp:        ts.Seconds,
+			Token:            change.Token,
+			Kind:             change.FromKind,
+			Counterparty:     change.To,
+			CounterpartyKind: change.ToKind,
+			Amount:           change.Amount,
+			Balance:          change.FromBalance,
+			Reason:           change.Reason,
+		}); err != nil {
+			return err
+		}
+	}
+	if len(change.To) != 0 {
+		if err = bc.addJournalEntry(change.To, &pb.JournalEntry{
+			TxID:             bc.stub.GetTxID(),
+			Timestamp:        ts.Seconds,
+			Token:            change.Token,
+			Kind:             change.ToKind,
+			Credit:           true,
+			Counterparty:     change.From,
+			CounterpartyKind: change.FromKind,
+			Amount:           change.Amount,
+			Balance:          change.ToBalance,
+			Reason:           change.Reason,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addJournalEntry writes the entry with the next sequence number of the address, stubs of transactions
+// return their own writes, so entries of the address written by one transaction don't share the sequence number
+func (bc *BaseContract) addJournalEntry(address []byte, entry *pb.JournalEntry) error {
+	addr := types.AddrFromBytes(address).String()
+
+	seqKey, err := bc.stub.CreateCompositeKey(journalSeqKey, []string{addr})
+	if err != nil {
+		return err
+	}
+	data, err := bc.stub.GetState(seqKey)
+	if err != nil {
+		return err
+	}
+	if len(data) != 0 {
+		if entry.Seq, err = strconv.ParseUint(string(data), 10, 64); err != nil { //nolint:gomnd
+			return fmt.Errorf("%w: %s", ErrJournalSeqCorrupted, err.Error())
+		}
+	}
+	entry.Seq++
+	if err = bc.stub.PutState(seqKey, []byte(strconv.FormatUint(entry.Seq, 10))); err != nil { //nolint:gomnd
+		return err
+	}
+
+	value, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	key, tokenKey, err := bc.journalKeys(addr, entry.Token, entry.Seq)
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.PutState(key, value); err != nil {
+		return err
+	}
+	if err = bc.stub.PutState(tokenKey, value); err != nil {
+		return err
+	}
+
+	if bc.journal.retention != 0 && entry.Seq > bc.journal.retention {
+		return bc.deleteJournalEntry(addr, entry.Seq-bc.journal.retention)
+	}
+	return nil
+}
+
+// deleteJournalEntry deletes the entry with the sequence number and its token index
+func (bc *BaseContract) deleteJournalEntry(addr string, seq uint64) error {
+	key, err := bc.stub.CreateCompositeKey(journalKey, []string{addr, journalSeq(seq)})
+	if err != nil {
+		return err
+	}
+	data, err := bc.stub.GetState(key)
+	if err != nil || len(data) == 0 {
+		return err
+	}
+	entry := &pb.JournalEntry{}
+	if err = proto.Unmarshal(data, entry); err != nil {
+		return err
+	}
+
+	_, tokenKey, err := bc.journalKeys(addr, entry.Token, seq)
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.DelState(key); err != nil {
+		return err
+	}
+	return bc.stub.DelState(tokenKey)
+}
+
+func (bc *BaseContract) journalKeys(addr string, token string, seq uint64) (string, string, error) {
+	key, err := bc.stub.CreateCompositeKey(journalKey, []string{addr, journalSeq(seq)})
+	if err != nil {
+		return "", "", err
+	}
+	tokenKey, err := bc.stub.CreateCompositeKey(journalTokenKey, []string{addr, token, journalSeq(seq)})
+	if err != nil {
+		return "", "", err
+	}
+	return key, tokenKey, nil
+}
+
+// journalSeq encodes the sequence number so that newer entries go first in range queries
+func journalSeq(seq uint64) string {
+	return fmt.Sprintf("%020d", uint64(math.MaxUint64)-seq)
+}
+
+// QueryAddressHistory returns journal entries of the address from the newest to the oldest.
+// If token isn't empty, only entries of the token are returned.
+// The bookmark of the response is used to get the next page, it's empty on the last page
+func (bc *BaseContract) QueryAddressHistory(address *types.Address, token string, pageSize int64, bookmark string) (*pb.JournalEntries, error) {
+	if !bc.journal.enabled {
+		return nil, ErrJournalDisabled
+	}
+	if pageSize < 1 || pageSize > maxJournalPageSize {
+		return nil, ErrJournalPageSize
+	}
+
+	objectType, attrs := journalKey, []string{address.String()}
+	if token != "" {
+		objectType, attrs = journalTokenKey, []string{address.String(), token}
+	}
+	if bookmark != "" {
+		prefix, err := bc.stub.CreateCompositeKey(objectType, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(bookmark, prefix) {
+			return nil, ErrJournalBookmark
+		}
+	}
+
+	iter, meta, err := bc.stub.GetStateByPartialCompositeKeyWithPagination(objectType, attrs, int32(pageSize), bookmark)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	result := &pb.JournalEntries{}
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		entry := &pb.JournalEntry{}
+		if err = proto.Unmarshal(kv.Value, entry); err != nil {
+			return nil, err
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	if meta != nil {
+		result.Bookmark = meta.Bookmark
+	}
+	return result, nil
+}
+
+// NBTxPruneAddressHistory deletes journal entries of the address which are beyond the journal retention,
+// e.g. after the retention was decreased. At most maxJournalPageSize entries are deleted per call,
+// the number of deleted entries is returned
+func (bc *BaseContract) NBTxPruneAddressHistory(sender *types.Sender, address *types.Address) (uint64, error) {
+	if err := bc.checkAdmin(sender, ErrJournalAdminOnly); err != nil {
+		return 0, err
+	}
+	if !bc.journal.enabled {
+		return 0, ErrJournalDisabled
+	}
+	if bc.journal.retention == 0 {
+		return 0, ErrJournalNoRetention
+	}
+
+	addr := address.String()
+	seqKey, err := bc.stub.CreateCompositeKey(journalSeqKey, []string{addr})
+	if err != nil {
+		return 0, err
+	}
+	data, err := bc.stub.GetState(seqKey)
+	if err != nil || len(data) == 0 {
+		return 0, err
+	}
+	last, err := strconv.ParseUint(string(data), 10, 64) //nolint:gomnd
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrJournalSeqCorrupted, err.Error())
+	}
+	if last <= bc.journal.retention {
+		return 0, nil
+	}
+
+	// entries go from the newest to the oldest, the first ones beyond the retention are deleted
+	startKey, err := bc.stub.CreateCompositeKey(journalKey, []string{addr, journalSeq(last - bc.journal.retention)})
+	if err != nil {
+		return 0, err
+	}
+	iter, err := bc.stub.GetStateByPartialCompositeKey(journalKey, []string{addr})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	var deleted uint64
+	for iter.HasNext() && deleted < maxJournalPageSize {
+		kv, err := iter.Next()
+		if err != nil {
+			return 0, err
+		}
+		if kv.Key < startKey {
+			continue
+		}
+		entry := &pb.JournalEntry{}
+		if err = proto.Unmarshal(kv.Value, entry); err != nil {
+			return 0, err
+		}
+		if err = bc.deleteJournalEntry(addr, entry.Seq); err != nil {
+			return 0, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournalEntriesOfAddressInTransaction(t *testing.T) {
+	stub := newPeerStub()
+	bc, _ := newEventContract(stub, true)
+	addr := &types.Address{Address: make([]byte, 32)}
+	addr.Address[0] = 1
+
+	require.NoError(t, bc.tokenBalanceAdd(addr, big.NewInt(10), "CC", "emit"))
+	require.NoError(t, bc.TokenBalanceLock(addr, big.NewInt(3)))
+
+	seqKey, err := stub.CreateCompositeKey(journalSeqKey, []string{addr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, "3", string(stub.writes[seqKey]))
+
+	// the lock journals both sides of the address, every entry keeps its own key
+	for seq, kind := range map[uint64]pb.BalanceChange_Kind{
+		1: pb.BalanceChange_TOKEN,
+		2: pb.BalanceChange_TOKEN,
+		3: pb.BalanceChange_LOCKED_TOKEN,
+	} {
+		key, _, err := bc.journalKeys(addr.String(), "CC", seq)
+		require.NoError(t, err)
+		entry := &pb.JournalEntry{}
+		require.NoError(t, proto.Unmarshal(stub.writes[key], entry))
+		assert.Equal(t, seq, entry.Seq)
+		assert.Equal(t, kind, entry.Kind)
+	}
+}
@@ -0,0 +1,285 @@
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/proto"
+)
+
+// kinds of external locks
+const (
+	LockKindToken      = "token"
+	LockKindAllowed    = "allowed"
+	LockKindIndustrial = "industrial"
+)
+
+// lock index keys, their attributes are [value, kind, lock id]
+const (
+	lockByAddressKey = "lockByAddress"
+	lockByTokenKey   = "lockByToken"
+	lockByReasonKey  = "lockByReason"
+	lockByTagKey     = "lockByTag"
+
+	// maxLockPageSize limits the number of locks returned or indexed in one call
+	maxLockPageSize = 1000
+)
+
+// lock index errors
+var (
+	ErrLockPageSize = errors.New("page size must be from 1 to 1000")
+	ErrLockBookmark = errors.New("invalid bookmark")
+)
+
+// lockRecord is implemented by TokenBalanceLock and AllowedBalanceLock
+type lockRecord interface {
+	GetId() string
+	GetAddress() string
+	GetToken() string
+	GetReason() string
+	GetTag() string
+}
+
+// LockInfo is a lock found by an index with its kind
+type LockInfo struct {
+	Kind          string   `json:"kind"`
+	ID            string   `json:"id"`
+	Address       string   `json:"address"`
+	Token         string   `json:"token"`
+	InitAmount    string   `json:"initAmount"`
+	CurrentAmount string   `json:"currentAmount"`
+	Reason        string   `json:"reason"`
+	Docs          [][]byte `json:"docs,omitempty"`
+	Payload       []byte   `json:"payload,omitempty"`
+	ExpiresAt     int64    `json:"expiresAt,omitempty"`
+	Beneficiary   string   `json:"beneficiary,omitempty"`
+	Tag           string   `json:"tag,omitempty"`
+}
+
+// Locks is a page of locks
+type Locks struct {
+	Locks    []*LockInfo `json:"locks"`
+	Bookmark string      `json:"bookmark"`
+}
+
+// lockIndexKeys returns index keys of the lock, the tag is indexed only if it's set
+func (bc *BaseContract) lockIndexKeys(kind string, lock lockRecord) ([]string, error) {
+	indexes := [][2]string{
+		{lockByAddressKey, lock.GetAddress()},
+		{lockByTokenKey, lock.GetToken()},
+		{lockByReasonKey, lock.GetReason()},
+	}
+	if lock.GetTag() != "" {
+		indexes = append(indexes, [2]string{lockByTagKey, lock.GetTag()})
+	}
+
+	keys := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		key, err := bc.stub.CreateCompositeKey(index[0], []string{index[1], kind, lock.GetId()})
+		if err != nil {
+			return nil, fmt.Errorf("create index key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// indexLock writes index keys of the lock, the value of the keys is the lock id
+func (bc *BaseContract) indexLock(kind string, lock lockRecord) error {
+	keys, err := bc.lockIndexKeys(kind, lock)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = bc.stub.PutState(key, []byte(lock.GetId())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unindexLock deletes index keys of the deleted lock
+func (bc *BaseContract) unindexLock(kind string, lock lockRecord) error {
+	keys, err := bc.lockIndexKeys(kind, lock)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = bc.stub.DelState(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryLocks returns a page of locks from the index
+func (bc *BaseContract) queryLocks(index string, value string, pageSize int64, bookmark string) (*Locks, error) {
+	if pageSize < 1 || pageSize > maxLockPageSize {
+		return nil, ErrLockPageSize
+	}
+	if bookmark != "" {
+		prefix, err := bc.stub.CreateCompositeKey(index, []string{value})
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(bookmark, prefix) {
+			return nil, ErrLockBookmark
+		}
+	}
+
+	iter, meta, err := bc.stub.GetStateByPartialCompositeKeyWithPagination(index, []string{value}, int32(pageSize), bookmark)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	result := &Locks{Locks: []*LockInfo{}}
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		_, attrs, err := bc.stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		if len(attrs) != 3 { //nolint:gomnd
+			return nil, fmt.Errorf("incorrect composite key %s (three-part key expected)", kv.Key)
+		}
+		lock, err := bc.lockInfo(attrs[1], attrs[2])
+		if err != nil {
+			return nil, err
+		}
+		result.Locks = append(result.Locks, lock)
+	}
+	if meta != nil {
+		result.Bookmark = meta.Bookmark
+	}
+	return result, nil
+}
+
+func (bc *BaseContract) lockInfo(kind string, lockID string) (*LockInfo, error) {
+	switch kind {
+	case LockKindToken, LockKindIndustrial:
+		getLock := bc.getLockedTokenBalance
+		if kind == LockKindIndustrial {
+			getLock = bc.getLockedIndustrialBalance
+		}
+		lock, err := getLock(lockID)
+		if err != nil {
+			return nil, err
+		}
+		return &LockInfo{
+			Kind: kind, ID: lock.Id, Address: lock.Address, Token: lock.Token,
+			InitAmount: lock.InitAmount, CurrentAmount: lock.CurrentAmount, Reason: lock.Reason,
+			Docs: lock.Docs, Payload: lock.Payload, ExpiresAt: lock.ExpiresAt, Beneficiary: lock.Beneficiary, Tag: lock.Tag,
+		}, nil
+	case LockKindAllowed:
+		lock, err := bc.getLockedAllowedBalance(lockID)
+		if err != nil {
+			return nil, err
+		}
+		return &LockInfo{
+			Kind: kind, ID: lock.Id, Address: lock.Address, Token: lock.Token,
+			InitAmount: lock.InitAmount, CurrentAmount: lock.CurrentAmount, Reason: lock.Reason,
+			Docs: lock.Docs, Payload: lock.Payload, ExpiresAt: lock.ExpiresAt, Beneficiary: lock.Beneficiary, Tag: lock.Tag,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown lock kind %s", kind)
+	}
+}
+
+// QueryLocksByAddress - returns token, allowed and industrial balance locks of the address
+func (bc *BaseContract) QueryLocksByAddress(address *types.Address, pageSize int64, bookmark string) (*Locks, error) {
+	return bc.queryLocks(lockByAddressKey, address.String(), pageSize, bookmark)
+}
+
+// QueryLocksByToken - returns locks of the token
+func (bc *BaseContract) QueryLocksByToken(token string, pageSize int64, bookmark string) (*Locks, error) {
+	return bc.queryLocks(lockByTokenKey, token, pageSize, bookmark)
+}
+
+// QueryLocksByReason - returns locks with the reason
+func (bc *BaseContract) QueryLocksByReason(reason string, pageSize int64, bookmark string) (*Locks, error) {
+	return bc.queryLocks(lockByReasonKey, reason, pageSize, bookmark)
+}
+
+// QueryLocksByTag - returns locks with the tag
+func (bc *BaseContract) QueryLocksByTag(tag string, pageSize int64, bookmark string) (*Locks, error) {
+	return bc.queryLocks(lockByTagKey, tag, pageSize, bookmark)
+}
+
+// NBTxIndexLocks - writes indexes of locks created before the indexes were introduced,
+// method is called by the chaincode admin. At most maxLockPageSize locks are indexed per call,
+// the number of indexed locks is returned, call it until it returns 0
+func (bc *BaseContract) NBTxIndexLocks(sender *types.Sender) (int, error) {
+	if err := bc.checkAdmin(sender, ErrPlatformAdminOnly); err != nil {
+		return 0, err
+	}
+
+	indexed := 0
+	for _, kind := range []struct {
+		name     string
+		stateKey StateKey
+		newLock  func() lockRecord
+	}{
+		{LockKindToken, StateKeyExternalLockedToken, func() lockRecord { return &proto.TokenBalanceLock{} }},
+		{LockKindAllowed, StateKeyExternalLockedAllowed, func() lockRecord { return &proto.AllowedBalanceLock{} }},
+		{LockKindIndustrial, StateKeyExternalLockedIndustrial, func() lockRecord { return &proto.TokenBalanceLock{} }},
+	} {
+		n, err := bc.indexLocks(kind.name, kind.stateKey, kind.newLock, maxLockPageSize-indexed)
+		if err != nil {
+			return 0, err
+		}
+		indexed += n
+	}
+	return indexed, nil
+}
+
+// indexLocks indexes at most limit locks of the kind which aren't indexed yet
+func (bc *BaseContract) indexLocks(kind string, stateKey StateKey, newLock func() lockRecord, limit int) (int, error) {
+	iter, err := bc.stub.GetStateByPartialCompositeKey(hex.EncodeToString([]byte{byte(stateKey)}), []string{})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	indexed := 0
+	for iter.HasNext() && indexed < limit {
+		kv, err := iter.Next()
+		if err != nil {
+			return 0, err
+		}
+		lock := newLock()
+		if err = json.Unmarshal(kv.Value, lock); err != nil {
+			return 0, fmt.Errorf("unmarshal lock state: %w", err)
+		}
+
+		// the address index is written with all other indexes
+		key, err := bc.stub.CreateCompositeKey(lockByAddressKey, []string{lock.GetAddress(), kind, lock.GetId()})
+		if err != nil {
+			return 0, err
+		}
+		data, err := bc.stub.GetState(key)
+		if err != nil {
+			return 0, err
+		}
+		if len(data) != 0 {
+			continue
+		}
+
+		if err = bc.indexLock(kind, lock); err != nil {
+			return 0, err
+		}
+		indexed++
+	}
+	return indexed, nil
+}
@@ -0,0 +1,275 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+// migration tracks, every track has its own schema version
+const (
+	MigrationTrackCore     = "core"
+	MigrationTrackContract = "contract"
+)
+
+const (
+	schemaVersionKey = "schemaVersion"
+
+	// maxMigrationPageSize limits the number of records processed by one step of the migration
+	maxMigrationPageSize = 1000
+)
+
+// migration errors
+var (
+	ErrMigrationAdminOnly = errors.New("migrations can be run only by the admin")
+	ErrMigrationPageSize  = errors.New("page size must be from 1 to 1000")
+	ErrMigrationVersion   = errors.New("migration versions must start from 1 and increase")
+)
+
+// Migration is a numbered change of the state, migrations of the track run in the order of versions.
+// Step processes at most pageSize records after the bookmark and returns the bookmark of the next page
+// and the number of processed records, the empty bookmark means the migration is over
+type Migration struct {
+	Version uint64
+	Name    string
+	Step    func(bc *BaseContract, bookmark string, pageSize int) (string, uint64, error)
+}
+
+// MigrationsProvider is implemented by contracts which declare migrations of their state
+type MigrationsProvider interface {
+	Migrations() []Migration
+}
+
+// migrationTracks run in this order
+var migrationTracks = []string{MigrationTrackCore, MigrationTrackContract}
+
+// versions of core migrations, legacy decoders of the state are used until the migration is over
+const (
+	coreMigrationLegacyNonces    = 1
+	coreMigrationLegacyPreimages = 2
+)
+
+// coreMigrations are migrations of the state kept by the core
+var coreMigrations = []Migration{
+	{Version: coreMigrationLegacyNonces, Name: "rewrite legacy raw nonces into pb.Nonce", Step: migrateLegacyNonces},
+	{Version: coreMigrationLegacyPreimages, Name: "rewrite legacy JSON preimages of batched transactions into pb.PendingTx", Step: migrateLegacyPreimages},
+}
+
+// MigrationProgress is the progress of the running migration
+type MigrationProgress struct {
+	Version   uint64 `json:"version"`
+	Name      string `json:"name"`
+	Bookmark  string `json:"bookmark"`
+	Processed uint64 `json:"processed"`
+	Pages     uint64 `json:"pages"`
+}
+
+// PendingMigration is a migration which hasn't finished yet
+type PendingMigration struct {
+	Version uint64 `json:"version"`
+	Name    string `json:"name"`
+}
+
+// MigrationTrackStatus is the schema version of the track and its pending migrations
+type MigrationTrackStatus struct {
+	Track string `json:"track"`
+	// Version is the version of the last finished migration
+	Version uint64              `json:"version"`
+	Pending []*PendingMigration `json:"pending"`
+	Current *MigrationProgress  `json:"current,omitempty"`
+}
+
+// MigrationStatus is the status of migrations of all tracks
+type MigrationStatus struct {
+	Tracks []*MigrationTrackStatus `json:"tracks"`
+	Done   bool                    `json:"done"`
+}
+
+type schemaVersion struct {
+	Version uint64             `json:"version"`
+	Current *MigrationProgress `json:"current,omitempty"`
+}
+
+// verifyMigrations checks that versions of migrations start from 1 and increase by 1
+func verifyMigrations(migrations []Migration) error {
+	for i, m := range migrations {
+		if m.Version != uint64(i+1) || m.Step == nil {
+			return fmt.Errorf("%w: %d %s", ErrMigrationVersion, m.Version, m.Name)
+		}
+	}
+	return nil
+}
+
+func (bc *BaseContract) loadSchemaVersion(track string) (string, *schemaVersion, error) {
+	return loadSchemaVersion(bc.stub, track)
+}
+
+func loadSchemaVersion(stub shim.ChaincodeStubInterface, track string) (string, *schemaVersion, error) {
+	key, err := stub.CreateCompositeKey(schemaVersionKey, []string{track})
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := stub.GetState(key)
+	if err != nil {
+		return "", nil, err
+	}
+	version := &schemaVersion{}
+	if len(data) != 0 {
+		if err = json.Unmarshal(data, version); err != nil {
+			return "", nil, err
+		}
+	}
+	return key, version, nil
+}
+
+// migrated returns true if the migration of the track is over
+func migrated(stub shim.ChaincodeStubInterface, track string, version uint64) (bool, error) {
+	_, schema, err := loadSchemaVersion(stub, track)
+	if err != nil {
+		return false, err
+	}
+	return schema.Version >= version, nil
+}
+
+// SchemaVersion returns the version of the last finished migration of the track
+func (bc *BaseContract) SchemaVersion(track string) (uint64, error) {
+	_, schema, err := bc.loadSchemaVersion(track)
+	if err != nil {
+		return 0, err
+	}
+	return schema.Version, nil
+}
+
+// NBTxRunMigrations runs one step of the first pending migration, core migrations run before ones
+// of the contract. Method is called by the admin until Done is returned
+func (bc *BaseContract) NBTxRunMigrations(sender *types.Sender, pageSize int) (*MigrationStatus, error) {
+	if err := bc.checkAdmin(sender, ErrMigrationAdminOnly); err != nil {
+		return nil, err
+	}
+	if pageSize < 1 || pageSize > maxMigrationPageSize {
+		return nil, ErrMigrationPageSize
+	}
+
+	for _, track := range migrationTracks {
+		migrations := bc.migrations[track]
+		key, version, err := bc.loadSchemaVersion(track)
+		if err != nil {
+			return nil, err
+		}
+		if version.Version >= uint64(len(migrations)) {
+			continue
+		}
+
+		m := migrations[version.Version]
+		if version.Current == nil || version.Current.Version != m.Version {
+			version.Current = &MigrationProgress{Version: m.Version, Name: m.Name}
+		}
+		next, processed, err := m.Step(bc, version.Current.Bookmark, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s %d: %w", track, m.Version, err)
+		}
+		version.Current.Bookmark = next
+		version.Current.Processed += processed
+		version.Current.Pages++
+		if next == "" {
+			version.Version = m.Version
+			version.Current = nil
+		}
+
+		data, err := json.Marshal(version)
+		if err != nil {
+			return nil, err
+		}
+		if err = bc.stub.PutState(key, data); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	return bc.QueryMigrationStatus()
+}
+
+// QueryMigrationStatus returns schema versions of tracks, pending migrations and the progress of running ones
+func (bc *BaseContract) QueryMigrationStatus() (*MigrationStatus, error) {
+	status := &MigrationStatus{Done: true}
+	for _, track := range migrationTracks {
+		_, version, err := bc.loadSchemaVersion(track)
+		if err != nil {
+			return nil, err
+		}
+		trackStatus := &MigrationTrackStatus{
+			Track:   track,
+			Version: version.Version,
+			Pending: []*PendingMigration{},
+			Current: version.Current,
+		}
+		for _, m := range bc.migrations[track] {
+			if m.Version > version.Version {
+				trackStatus.Pending = append(trackStatus.Pending, &PendingMigration{Version: m.Version, Name: m.Name})
+			}
+		}
+		status.Done = status.Done && len(trackStatus.Pending) == 0
+		status.Tracks = append(status.Tracks, trackStatus)
+	}
+	return status, nil
+}
+
+// migrateLegacyNonces rewrites nonces of the prefix of the chaincode into pb.Nonce like NBTxMigrateNonces
+func migrateLegacyNonces(bc *BaseContract, bookmark string, pageSize int) (string, uint64, error) {
+	progress := &NonceMigrationProgress{Bookmark: bookmark}
+	if err := bc.migrateNoncePage(bc.nonce.prefix, bc.nonce.prefix, progress, pageSize); err != nil {
+		return "", 0, err
+	}
+	if progress.Done {
+		return "", progress.Processed, nil
+	}
+	return progress.Bookmark, progress.Processed, nil
+}
+
+// migrateLegacyPreimages rewrites preimages of batched transactions stored as JSON []string into pb.PendingTx
+func migrateLegacyPreimages(bc *BaseContract, bookmark string, pageSize int) (string, uint64, error) {
+	// paginated queries aren't supported in update transactions, so the page is cut manually
+	iter, err := bc.stub.GetStateByPartialCompositeKey(bc.batchPrefix, []string{})
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	var processed uint64
+	last := ""
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return "", 0, err
+		}
+		if kv.Key <= bookmark {
+			continue
+		}
+		if processed == uint64(pageSize) {
+			return last, processed, nil
+		}
+
+		pending, legacy, err := unmarshalPendingTx(kv.Value, true)
+		if err != nil {
+			return "", 0, err
+		}
+		if legacy {
+			value, err := proto.Marshal(pending)
+			if err != nil {
+				return "", 0, err
+			}
+			if err = bc.stub.PutState(kv.Key, value); err != nil {
+				return "", 0, err
+			}
+		}
+		last = kv.Key
+		processed++
+	}
+	return "", processed, nil
+}
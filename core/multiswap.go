@@ -60,8 +60,8 @@ func multiSwapAnswer(stub *batchStub, swap *proto.MultiSwap) (r *proto.SwapRespo
 	if _, err = MultiSwapSave(txStub, hex.EncodeToString(swap.Id), swap); err != nil {
 		return &proto.SwapResponse{Id: swap.Id, Error: &proto.ResponseError{Error: err.Error()}}
 	}
-	writes, _ := txStub.Commit()
-	return &proto.SwapResponse{Id: swap.Id, Writes: writes}
+	writes, events := txStub.Commit()
+	return &proto.SwapResponse{Id: swap.Id, Writes: writes, Events: events}
 }
 
 func multiSwapRobotDone(stub *batchStub, swapID []byte, key string) (r *proto.SwapResponse) {
@@ -93,8 +93,8 @@ func multiSwapRobotDone(stub *batchStub, swapID []byte, key string) (r *proto.Sw
 	if err = MultiSwapDel(txStub, hex.EncodeToString(swapID)); err != nil {
 		return &proto.SwapResponse{Id: swapID, Error: &proto.ResponseError{Error: err.Error()}}
 	}
-	writes, _ := txStub.Commit()
-	return &proto.SwapResponse{Id: swapID, Writes: writes}
+	writes, events := txStub.Commit()
+	return &proto.SwapResponse{Id: swapID, Writes: writes, Events: events}
 }
 
 func multiSwapUserDone(bc BaseContractInterface, swapID string, key string) peer.Response {
@@ -175,7 +175,7 @@ func (bc *BaseContract) TxMultiSwapBegin(sender *types.Sender, token string, mul
 	switch {
 	case swap.Token == swap.From:
 		for _, asset := range swap.Assets {
-			if err = bc.tokenBalanceSub(types.AddrFromBytes(swap.Owner), new(big.Int).SetBytes(asset.Amount), asset.Group); err != nil {
+			if err = bc.tokenBalanceSub(types.AddrFromBytes(swap.Owner), new(big.Int).SetBytes(asset.Amount), asset.Group, MultiSwapReason); err != nil {
 				return "", err
 			}
 		}
@@ -218,7 +218,7 @@ func (bc *BaseContract) TxMultiSwapCancel(sender *types.Sender, swapID string) e
 	switch {
 	case bytes.Equal(swap.Creator, swap.Owner) && swap.Token == swap.From:
 		for _, asset := range swap.Assets {
-			if err = bc.tokenBalanceAdd(types.AddrFromBytes(swap.Owner), new(big.Int).SetBytes(asset.Amount), asset.Group); err != nil {
+			if err = bc.tokenBalanceAdd(types.AddrFromBytes(swap.Owner), new(big.Int).SetBytes(asset.Amount), asset.Group, MultiSwapReason); err != nil {
 				return err
 			}
 		}
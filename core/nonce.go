@@ -2,6 +2,7 @@ package core
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
@@ -17,47 +18,182 @@ import (
 const (
 	doublingMemoryCoef    = 2
 	lenTimeInMilliseconds = 13
+
+	noncePrefixDefault = "nonce"
+	noncePrefixPassed  = "passedNonce"
 )
 
-func checkNonce(nonceTTL uint, prefix StateKey) NonceCheckFn {
+// ErrLegacyNonce is returned when the nonce is stored as raw bytes and legacy nonces are disabled
+var ErrLegacyNonce = errors.New("nonce is stored in legacy format, nonce migration is required")
+
+// NonceWindow is a set of nonces used by the address which are still inside the nonce TTL window
+type NonceWindow struct {
+	// Prefix is the name of the active nonce prefix: "nonce" (StateKeyNonce) or "passedNonce" (StateKeyPassedNonce)
+	Prefix string `json:"prefix"`
+	// TTL is the NonceTTL option in seconds, 0 means that every new nonce must be greater than the last one
+	TTL uint `json:"ttl"`
+	// Nonces are used nonces sorted in ascending order
+	Nonces []uint64 `json:"nonces"`
+	// Last is the greatest used nonce
+	Last uint64 `json:"last"`
+	// Min is the least nonce which can still be accepted if it's not used yet
+	Min uint64 `json:"min"`
+	// Legacy is true if the nonce is stored in the old raw format
+	Legacy bool `json:"legacy"`
+}
+
+// NonceCheckResult is a result of the nonce dry run check
+type NonceCheckResult struct {
+	Nonce    uint64 `json:"nonce"`
+	Accepted bool   `json:"accepted"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// nonceSettings describes how nonces are stored and checked
+type nonceSettings struct {
+	prefix         StateKey
+	ttl            uint
+	legacyDisabled bool
+}
+
+func (ns nonceSettings) prefixName() string {
+	return noncePrefixName(ns.prefix)
+}
+
+func noncePrefixName(prefix StateKey) string {
+	if prefix == StateKeyPassedNonce {
+		return noncePrefixPassed
+	}
+	return noncePrefixDefault
+}
+
+func noncePrefixByName(name string) (StateKey, error) {
+	switch name {
+	case noncePrefixDefault:
+		return StateKeyNonce, nil
+	case noncePrefixPassed:
+		return StateKeyPassedNonce, nil
+	default:
+		return 0, fmt.Errorf("unknown nonce prefix %s", name)
+	}
+}
+
+func nonceKey(stub shim.ChaincodeStubInterface, prefix StateKey, address *types.Address) (string, error) {
+	noncePrefix := hex.EncodeToString([]byte{byte(prefix)})
+	return stub.CreateCompositeKey(noncePrefix, []string{address.String()})
+}
+
+// unmarshalNonce parses stored nonce, legacy is true if the nonce is stored as raw bytes
+func unmarshalNonce(data []byte, legacyDisabled bool) (*pb.Nonce, bool, error) {
+	lastNonce := new(pb.Nonce)
+	if len(data) == 0 {
+		return lastNonce, false, nil
+	}
+	if err := proto.Unmarshal(data, lastNonce); err != nil {
+		if legacyDisabled {
+			return nil, false, fmt.Errorf("%w: %s", ErrLegacyNonce, err.Error())
+		}
+		logger := Logger()
+		logger.Warningf("error unmarshal nonce, maybe old nonce. error: %v", err)
+		// let's just say it's an old nonse
+		lastNonce.Nonce = []uint64{new(big.Int).SetBytes(data).Uint64()}
+		return lastNonce, true, nil
+	}
+
+	return lastNonce, false, nil
+}
+
+// load returns nonces stored for the address, legacy is true if the nonce is stored as raw bytes
+func (ns nonceSettings) load(stub shim.ChaincodeStubInterface, address *types.Address) (*pb.Nonce, bool, error) {
+	key, err := nonceKey(stub, ns.prefix, address)
+	if err != nil {
+		return nil, false, err
+	}
+	data, err := stub.GetState(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// raw nonces are read until they're rewritten by the core migration
+	legacyDisabled := ns.legacyDisabled
+	if !legacyDisabled && len(data) != 0 {
+		if legacyDisabled, err = migrated(stub, MigrationTrackCore, coreMigrationLegacyNonces); err != nil {
+			return nil, false, err
+		}
+	}
+	return unmarshalNonce(data, legacyDisabled)
+}
+
+func checkNonce(ns nonceSettings) NonceCheckFn {
 	return func(stub shim.ChaincodeStubInterface, sender *types.Sender, nonce uint64) error {
-		noncePrefix := hex.EncodeToString([]byte{byte(prefix)})
-		nonceKey, err := stub.CreateCompositeKey(noncePrefix, []string{sender.Address().String()})
+		key, err := nonceKey(stub, ns.prefix, sender.Address())
 		if err != nil {
 			return err
 		}
-		data, err := stub.GetState(nonceKey)
+		lastNonce, _, err := ns.load(stub, sender.Address())
 		if err != nil {
 			return err
 		}
 
-		lastNonce := new(pb.Nonce)
-		if len(data) > 0 {
-			if err = proto.Unmarshal(data, lastNonce); err != nil {
-				logger := Logger()
-				logger.Warningf("error unmarshal nonce, maybe old nonce. error: %v", err)
-				// let's just say it's an old nonse
-				lastNonce.Nonce = []uint64{new(big.Int).SetBytes(data).Uint64()}
-			}
-		}
-
-		mayBeOtherSorting := false
-		if prefix == StateKeyPassedNonce {
-			mayBeOtherSorting = true
-		}
-
-		lastNonce.Nonce, err = setNonce(nonce, lastNonce.Nonce, nonceTTL, mayBeOtherSorting)
+		lastNonce.Nonce, err = setNonce(nonce, lastNonce.Nonce, ns.ttl, ns.prefix == StateKeyPassedNonce)
 		if err != nil {
 			return err
 		}
 
-		data, err = proto.Marshal(lastNonce)
+		data, err := proto.Marshal(lastNonce)
 		if err != nil {
 			return err
 		}
 
-		return stub.PutState(nonceKey, data)
+		return stub.PutState(key, data)
+	}
+}
+
+// window returns nonces of the address inside the TTL window
+func (ns nonceSettings) window(stub shim.ChaincodeStubInterface, address *types.Address) (*NonceWindow, error) {
+	lastNonce, legacy, err := ns.load(stub, address)
+	if err != nil {
+		return nil, err
+	}
+
+	window := &NonceWindow{
+		Prefix: ns.prefixName(),
+		TTL:    ns.ttl,
+		Nonces: append([]uint64{}, lastNonce.Nonce...),
+		Legacy: legacy,
+	}
+	sort.Slice(window.Nonces, func(i, j int) bool { return window.Nonces[i] < window.Nonces[j] })
+
+	if l := len(window.Nonces); l > 0 {
+		window.Last = window.Nonces[l-1]
+		window.Min = window.Last + 1
+		if ns.ttl != 0 {
+			window.Min = 0
+			if ttl := uint64((time.Second * time.Duration(ns.ttl)).Milliseconds()); window.Last > ttl {
+				window.Min = window.Last - ttl
+			}
+		}
+	}
+
+	return window, nil
+}
+
+// dryRun checks whether the nonce would be accepted for the address without saving it
+func (ns nonceSettings) dryRun(stub shim.ChaincodeStubInterface, address *types.Address, nonce uint64) (*NonceCheckResult, error) {
+	lastNonce, _, err := ns.load(stub, address)
+	if err != nil {
+		return nil, err
 	}
+
+	result := &NonceCheckResult{Nonce: nonce, Accepted: true}
+	// setNonce may insert the nonce in place, so it gets a copy
+	nonces := append(make([]uint64, 0, len(lastNonce.Nonce)), lastNonce.Nonce...)
+	if _, err = setNonce(nonce, nonces, ns.ttl, ns.prefix == StateKeyPassedNonce); err != nil {
+		result.Accepted = false
+		result.Reason = err.Error()
+	}
+
+	return result, nil
 }
 
 func setNonce(nonce uint64, lastNonce []uint64, nonceTTL uint, mayBeOtherSorting bool) ([]uint64, error) {
@@ -0,0 +1,232 @@
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+const (
+	nonceMigrationKey = "nonceMigration"
+
+	// maxNonceMigrationPageSize limits the number of nonce records rewritten in one transaction
+	maxNonceMigrationPageSize = 1000
+)
+
+// nonce migration errors
+var (
+	ErrNonceMigrationAdminOnly = errors.New("nonce migration is available only for the admin")
+	ErrNonceMigrationPageSize  = errors.New("page size must be from 1 to 1000")
+)
+
+// NonceMigrationProgress is the progress of rewriting nonce records from one prefix to another
+type NonceMigrationProgress struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Processed is the number of rewritten records
+	Processed uint64 `json:"processed"`
+	// Converted is the number of records which were stored in the legacy raw format
+	Converted uint64 `json:"converted"`
+	// Merged is the number of records merged into the already existing records of the target prefix
+	Merged uint64 `json:"merged"`
+	Pages  uint64 `json:"pages"`
+	// Bookmark is the last processed key, the next page starts after it
+	Bookmark string `json:"bookmark"`
+	Done     bool   `json:"done"`
+}
+
+// NBTxMigrateNonces rewrites nonce records stored under the "from" prefix ("nonce" or "passedNonce")
+// into the pb.Nonce format under the "to" prefix. It processes at most pageSize records per call
+// and continues from the saved bookmark until Done is returned.
+// If from and to differ, nonces are merged with records of the target prefix and source records are deleted
+func (bc *BaseContract) NBTxMigrateNonces(sender *types.Sender, from string, to string, pageSize int) (*NonceMigrationProgress, error) {
+	if err := bc.checkAdmin(sender, ErrNonceMigrationAdminOnly); err != nil {
+		return nil, err
+	}
+	if pageSize < 1 || pageSize > maxNonceMigrationPageSize {
+		return nil, ErrNonceMigrationPageSize
+	}
+	fromPrefix, err := noncePrefixByName(from)
+	if err != nil {
+		return nil, err
+	}
+	toPrefix, err := noncePrefixByName(to)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := loadNonceMigrationProgress(bc.stub, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if progress.Done {
+		// the previous migration is over, start again
+		progress = &NonceMigrationProgress{From: from, To: to}
+	}
+
+	if err = bc.migrateNoncePage(fromPrefix, toPrefix, progress, pageSize); err != nil {
+		return nil, err
+	}
+	progress.Pages++
+
+	if err = saveNonceMigrationProgress(bc.stub, progress); err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+// migrateNoncePage rewrites at most pageSize nonce records after the bookmark of the progress,
+// Done is set if no records are left
+func (bc *BaseContract) migrateNoncePage(fromPrefix, toPrefix StateKey, progress *NonceMigrationProgress, pageSize int) error {
+	// paginated queries aren't supported in update transactions, so the page is cut manually.
+	// Records moved to another prefix are deleted, so the page starts from the first record
+	// and the whole migration reads every record once. Range queries can't start inside composite
+	// keys, so records rewritten in place are skipped up to the bookmark
+	inPlace := fromPrefix == toPrefix
+	iter, err := bc.stub.GetStateByPartialCompositeKey(hex.EncodeToString([]byte{byte(fromPrefix)}), []string{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	count := 0
+	progress.Done = true
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return err
+		}
+		if inPlace && kv.Key <= progress.Bookmark {
+			continue
+		}
+		if count == pageSize {
+			progress.Done = false
+			break
+		}
+
+		if err = bc.migrateNonce(kv.Key, kv.Value, fromPrefix, toPrefix, progress); err != nil {
+			return err
+		}
+		progress.Bookmark = kv.Key
+		count++
+	}
+	return nil
+}
+
+// QueryNonceMigrationProgress returns the progress of the nonce migration from one prefix to another
+func (bc *BaseContract) QueryNonceMigrationProgress(from string, to string) (*NonceMigrationProgress, error) {
+	if _, err := noncePrefixByName(from); err != nil {
+		return nil, err
+	}
+	if _, err := noncePrefixByName(to); err != nil {
+		return nil, err
+	}
+	return loadNonceMigrationProgress(bc.stub, from, to)
+}
+
+func (bc *BaseContract) migrateNonce(key string, data []byte, fromPrefix, toPrefix StateKey, progress *NonceMigrationProgress) error {
+	lastNonce, legacy, err := unmarshalNonce(data, false)
+	if err != nil {
+		return err
+	}
+	if legacy {
+		progress.Converted++
+	}
+
+	targetKey := key
+	if fromPrefix != toPrefix {
+		_, attrs, err := bc.stub.SplitCompositeKey(key)
+		if err != nil {
+			return err
+		}
+		targetKey, err = bc.stub.CreateCompositeKey(hex.EncodeToString([]byte{byte(toPrefix)}), attrs)
+		if err != nil {
+			return err
+		}
+
+		existing, err := bc.stub.GetState(targetKey)
+		if err != nil {
+			return err
+		}
+		if len(existing) != 0 {
+			targetNonce, _, err := unmarshalNonce(existing, false)
+			if err != nil {
+				return err
+			}
+			lastNonce.Nonce = mergeNonces(lastNonce.Nonce, targetNonce.Nonce)
+			progress.Merged++
+		}
+
+		if err = bc.stub.DelState(key); err != nil {
+			return err
+		}
+	}
+
+	// nonces of the "passedNonce" prefix may be sorted in descending order
+	sort.Slice(lastNonce.Nonce, func(i, j int) bool { return lastNonce.Nonce[i] < lastNonce.Nonce[j] })
+
+	value, err := proto.Marshal(lastNonce)
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.PutState(targetKey, value); err != nil {
+		return err
+	}
+	progress.Processed++
+
+	return nil
+}
+
+// mergeNonces returns a union of two nonce lists without duplicates
+func mergeNonces(a []uint64, b []uint64) []uint64 {
+	seen := make(map[uint64]struct{}, len(a)+len(b))
+	result := make([]uint64, 0, len(a)+len(b))
+	for _, nonce := range append(append([]uint64{}, a...), b...) {
+		if _, ok := seen[nonce]; ok {
+			continue
+		}
+		seen[nonce] = struct{}{}
+		result = append(result, nonce)
+	}
+	return result
+}
+
+func loadNonceMigrationProgress(stub shim.ChaincodeStubInterface, from string, to string) (*NonceMigrationProgress, error) {
+	key, err := stub.CreateCompositeKey(nonceMigrationKey, []string{from, to})
+	if err != nil {
+		return nil, err
+	}
+	data, err := stub.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &NonceMigrationProgress{From: from, To: to}
+	if len(data) == 0 {
+		return progress, nil
+	}
+	if err = json.Unmarshal(data, progress); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+func saveNonceMigrationProgress(stub shim.ChaincodeStubInterface, progress *NonceMigrationProgress) error {
+	key, err := stub.CreateCompositeKey(nonceMigrationKey, []string{progress.From, progress.To})
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	return stub.PutState(key, data)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/atomyze-foundation/foundation/core/types"
 	pb "github.com/atomyze-foundation/foundation/proto"
 	"github.com/stretchr/testify/assert"
 )
@@ -142,3 +143,54 @@ func TestNonceFailRepeat(t *testing.T) {
 	assert.EqualError(t, err, "nonce 1660055050020 already exists")
 	assert.Equal(t, []uint64{1660055050000, 1660055050010, 1660055050020}, lastNonce.Nonce)
 }
+
+func TestNonceWindow(t *testing.T) {
+	stub := newMockStub()
+	sender := types.NewSenderFromAddr(&types.Address{Address: make([]byte, 32)})
+	check := checkNonce(nonceSettings{prefix: StateKeyNonce, ttl: 50})
+
+	window, err := nonceSettings{prefix: StateKeyNonce, ttl: 50}.window(stub, sender.Address())
+	assert.NoError(t, err)
+	assert.Equal(t, &NonceWindow{Prefix: "nonce", TTL: 50, Nonces: []uint64{}}, window)
+
+	for _, nonce := range []uint64{1660055050000, 1660055050020, 1660055050010} {
+		assert.NoError(t, check(stub, sender, nonce))
+	}
+
+	window, err = nonceSettings{prefix: StateKeyNonce, ttl: 50}.window(stub, sender.Address())
+	assert.NoError(t, err)
+	assert.Equal(t, &NonceWindow{
+		Prefix: "nonce",
+		TTL:    50,
+		Nonces: []uint64{1660055050000, 1660055050010, 1660055050020},
+		Last:   1660055050020,
+		Min:    1660055000020,
+	}, window)
+}
+
+func TestDryRunNonce(t *testing.T) {
+	stub := newMockStub()
+	sender := types.NewSenderFromAddr(&types.Address{Address: make([]byte, 32)})
+	assert.NoError(t, checkNonce(nonceSettings{prefix: StateKeyNonce, ttl: 50})(stub, sender, 1660055050010))
+
+	testCases := []struct {
+		nonce  uint64
+		reason string
+	}{
+		{1660055050020, ""},
+		{1660055050000, ""},
+		{1660055050010, "nonce 1660055050010 already exists"},
+		{1660054050000, "incorrect nonce 1660054050000, less than 1660055050010"},
+		{1, "incorrect nonce format"},
+	}
+	for _, tc := range testCases {
+		result, err := nonceSettings{prefix: StateKeyNonce, ttl: 50}.dryRun(stub, sender.Address(), tc.nonce)
+		assert.NoError(t, err)
+		assert.Equal(t, &NonceCheckResult{Nonce: tc.nonce, Accepted: tc.reason == "", Reason: tc.reason}, result)
+	}
+
+	// dry run doesn't change the stored window
+	window, err := nonceSettings{prefix: StateKeyNonce, ttl: 50}.window(stub, sender.Address())
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{1660055050010}, window.Nonces)
+}
@@ -11,6 +11,11 @@ package core
 // If NonceTTL = 0, then the check is done "the old way" when adding preimages.
 // IsOtherNoncePrefix - historically, Atomyze-US uses a different prefix for nonces.
 // We are obligated to support different prefixes, but it's not worth creating more of them. Therefore, it's only a flag.
+// DisableLegacyNonce - nonces stored as raw bytes are rejected instead of being read "the old way".
+// Set it after all nonces are rewritten by NBTxMigrateNonces.
+// EnableJournal - every balance change is written to the per-address journal, see QueryAddressHistory.
+// JournalRetention - number of the latest journal entries kept for every address, older entries are deleted
+// when new ones are written. 0 keeps all entries.
 
 // ContractOptions is a struct for contract options
 type ContractOptions struct {
@@ -21,4 +26,7 @@ type ContractOptions struct {
 	BatchPrefix        string
 	NonceTTL           uint
 	IsOtherNoncePrefix bool
+	DisableLegacyNonce bool
+	EnableJournal      bool
+	JournalRetention   uint64
 }
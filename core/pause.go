@@ -0,0 +1,199 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+const (
+	pauseKey = "pause"
+
+	// PausedEvent - event on the token paused or its pause changed
+	PausedEvent = "Paused"
+	// UnpausedEvent - event on the pause lifted
+	UnpausedEvent = "Unpaused"
+
+	createCCTransferToMethod = "createCCTransferTo"
+	swapDoneMethod           = "swapDone"
+	multiSwapDoneMethod      = "multiSwapDone"
+)
+
+// pause errors
+var (
+	ErrPauseAdminOnly = errors.New("pause is managed by the admin")
+	ErrPaused         = errors.New("paused")
+	ErrNotPaused      = errors.New("isn't paused")
+	ErrPauseMethod    = errors.New("method can't be paused")
+)
+
+// pauseExemptMethods run during the global pause: the pause itself, the robot side of channel transfers
+// (createCCTransferTo is rejected only if blockCCTransfers is set) and the methods the admin
+// needs to respond to an incident: role rotation and freezing of addresses
+var pauseExemptMethods = map[string]struct{}{
+	"pause":                  {},
+	"unpause":                {},
+	createCCTransferToMethod: {},
+	"cancelCCTransferFrom":   {},
+	"commitCCTransferFrom":   {},
+	"deleteCCTransferFrom":   {},
+	"deleteCCTransferTo":     {},
+	"proposeRole":            {},
+	"acceptRole":             {},
+	"cancelRoleProposal":     {},
+	"freezeAddress":          {},
+	"unfreezeAddress":        {},
+}
+
+// PauseStatus is the pause of the token, Methods are paused if the pause isn't global
+type PauseStatus struct {
+	Paused           bool     `json:"paused"`
+	Global           bool     `json:"global"`
+	Methods          []string `json:"methods"`
+	BlockSwaps       bool     `json:"blockSwaps"`
+	BlockCCTransfers bool     `json:"blockCCTransfers"`
+	Reason           string   `json:"reason"`
+	Timestamp        int64    `json:"timestamp"`
+}
+
+// blocks returns true if the method can't run during the pause
+func (p *PauseStatus) blocks(method string) bool {
+	if !p.Paused {
+		return false
+	}
+	switch method {
+	case createCCTransferToMethod:
+		return p.BlockCCTransfers
+	case swapDoneMethod, multiSwapDoneMethod:
+		return p.Global || p.BlockSwaps
+	}
+	if contains(p.Methods, method) {
+		return true
+	}
+	_, exempt := pauseExemptMethods[method]
+	return p.Global && !exempt
+}
+
+func getPause(stub shim.ChaincodeStubInterface) (*PauseStatus, error) {
+	data, err := stub.GetState(pauseKey)
+	if err != nil {
+		return nil, err
+	}
+	status := &PauseStatus{Methods: []string{}}
+	if len(data) == 0 {
+		return status, nil
+	}
+	if err = json.Unmarshal(data, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// checkPaused returns ErrPaused if the Tx or NBTx method, swapDone or multiSwapDone can't run because of the pause
+func checkPaused(stub shim.ChaincodeStubInterface, method string) error {
+	status, err := getPause(stub)
+	if err != nil {
+		return err
+	}
+	if status.blocks(method) {
+		return fmt.Errorf("%w: method %s", ErrPaused, method)
+	}
+	return nil
+}
+
+func pausedSwapResponse(swapID []byte) *proto.SwapResponse {
+	return &proto.SwapResponse{Id: swapID, Error: &proto.ResponseError{Error: fmt.Sprintf("%s: swap answers", ErrPaused.Error())}}
+}
+
+// NBTxPause pauses the token, method is called by the admin. The empty methods pause all Tx and NBTx
+// methods, otherwise only the comma separated methods are paused. Queries work during the pause.
+// blockSwaps also rejects swap answers and swapDone, blockCCTransfers rejects createCCTransferTo.
+// The new pause replaces the previous one
+func (bc *BaseContract) NBTxPause(sender *types.Sender, methods string, blockSwaps bool, blockCCTransfers bool, reason string) error {
+	if err := bc.checkAdmin(sender, ErrPauseAdminOnly); err != nil {
+		return err
+	}
+	if reason == "" {
+		return ErrReason
+	}
+
+	status := &PauseStatus{
+		Paused:           true,
+		Global:           methods == "",
+		Methods:          []string{},
+		BlockSwaps:       blockSwaps,
+		BlockCCTransfers: blockCCTransfers,
+		Reason:           reason,
+	}
+	if !status.Global {
+		for _, method := range strings.Split(methods, ",") {
+			method = strings.TrimSpace(method)
+			if method == "pause" || method == "unpause" || method == createCCTransferToMethod ||
+				!contains(bc.GetMethods(), method) {
+				return fmt.Errorf("%w: %s", ErrPauseMethod, method)
+			}
+			if !contains(status.Methods, method) {
+				status.Methods = append(status.Methods, method)
+			}
+		}
+		sort.Strings(status.Methods)
+	}
+
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+	status.Timestamp = ts.Seconds
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.SetEvent(PausedEvent, data); err != nil {
+		return err
+	}
+	return bc.stub.PutState(pauseKey, data)
+}
+
+// NBTxUnpause lifts the pause, method is called by the admin
+func (bc *BaseContract) NBTxUnpause(sender *types.Sender, reason string) error {
+	if err := bc.checkAdmin(sender, ErrPauseAdminOnly); err != nil {
+		return err
+	}
+	if reason == "" {
+		return ErrReason
+	}
+
+	status, err := getPause(bc.stub)
+	if err != nil {
+		return err
+	}
+	if !status.Paused {
+		return ErrNotPaused
+	}
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+	status.Paused = false
+	status.Reason = reason
+	status.Timestamp = ts.Seconds
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.SetEvent(UnpausedEvent, data); err != nil {
+		return err
+	}
+	return bc.stub.DelState(pauseKey)
+}
+
+// QueryPauseStatus returns the pause of the token, paused is false if the token isn't paused
+func (bc *BaseContract) QueryPauseStatus() (*PauseStatus, error) {
+	return getPause(bc.stub)
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"github.com/atomyze-foundation/foundation/core/helpers"
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 )
 
@@ -20,6 +21,11 @@ func newQueryStub(stub shim.ChaincodeStubInterface) *queryStub {
 	}
 }
 
+// ACLProvider returns the ACL provider of the wrapped stub
+func (qs *queryStub) ACLProvider() helpers.ACLProvider {
+	return helpers.ACL(qs.ChaincodeStubInterface)
+}
+
 func (qs *queryStub) PutState(_ string, _ []byte) error {
 	return nil
 }
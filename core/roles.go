@@ -0,0 +1,218 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+)
+
+// roles of the chaincode, the registry is seeded with the address
+// from the init arg at the position of the role
+const (
+	RoleAdmin            = "admin" // the issuer of the token
+	RoleFeeSetter        = "feeSetter"
+	RoleFeeAddressSetter = "feeAddressSetter"
+)
+
+var roleArgPositions = map[string]int{
+	RoleAdmin:            argPositionAdmin,
+	RoleFeeSetter:        1,
+	RoleFeeAddressSetter: 2, //nolint:gomnd
+}
+
+// roles are listed in this order
+var roles = []string{RoleAdmin, RoleFeeSetter, RoleFeeAddressSetter}
+
+const (
+	roleKey        = "roleHolder"
+	roleProposeKey = "roleProposed"
+
+	// RoleProposedEvent - event on the new holder of the role proposed
+	RoleProposedEvent = "RoleProposed"
+	// RoleAcceptedEvent - event on the role accepted by the new holder
+	RoleAcceptedEvent = "RoleAccepted"
+	// RoleProposalCancelledEvent - event on the proposal of the role cancelled by the admin
+	RoleProposalCancelledEvent = "RoleProposalCancelled"
+)
+
+// role errors
+var (
+	ErrRoleUnknown       = errors.New("unknown role")
+	ErrRoleNotSet        = errors.New("role holder isn't set")
+	ErrRoleAdminOnly     = errors.New("roles can be rotated only by the admin")
+	ErrRoleNotProposed   = errors.New("role isn't proposed to the sender")
+	ErrRoleAlreadyHolder = errors.New("address already holds the role")
+	ErrRoleNoProposal    = errors.New("role isn't proposed")
+)
+
+// RoleHolder is the current holder of the role and the proposed one if the rotation is in progress
+type RoleHolder struct {
+	Role     string `json:"role"`
+	Address  string `json:"address"`
+	Proposed string `json:"proposed,omitempty"`
+}
+
+func (bc *BaseContract) roleAddress(key string, role string) (*types.Address, error) {
+	compositeKey, err := bc.stub.CreateCompositeKey(key, []string{role})
+	if err != nil {
+		return nil, err
+	}
+	data, err := bc.stub.GetState(compositeKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return types.AddrFromBytes(data), nil
+}
+
+// GetRoleHolder returns the holder of the role from the registry, the registry is seeded
+// with the address from the init args on the first use of the role, so the holder doesn't
+// change if init args change on the upgrade of the chaincode
+func (bc *BaseContract) GetRoleHolder(role string) (*types.Address, error) {
+	pos, ok := roleArgPositions[role]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrRoleUnknown, role)
+	}
+	addr, err := bc.roleAddress(roleKey, role)
+	if err != nil || addr != nil {
+		return addr, err
+	}
+
+	if bc.GetInitArg(pos) == "" {
+		return nil, fmt.Errorf("%w: %s", ErrRoleNotSet, role)
+	}
+	addr, err = types.AddrFromBase58Check(bc.GetInitArg(pos))
+	if err != nil {
+		return nil, fmt.Errorf("%s init arg: %w", role, err)
+	}
+	key, err := bc.stub.CreateCompositeKey(roleKey, []string{role})
+	if err != nil {
+		return nil, err
+	}
+	// writes of queries are discarded, the registry is seeded by the first transaction
+	if err = bc.stub.PutState(key, addr.Bytes()); err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
+
+// TxProposeRole proposes the address as the new holder of the role, the role passes to it
+// after it accepts the role. Method is called by the admin, the new proposal replaces the previous one
+func (bc *BaseContract) TxProposeRole(sender *types.Sender, role string, address *types.Address) error {
+	if err := bc.checkAdmin(sender, ErrRoleAdminOnly); err != nil {
+		return err
+	}
+	holder, err := bc.GetRoleHolder(role)
+	if err != nil && !errors.Is(err, ErrRoleNotSet) {
+		return err
+	}
+	if holder != nil && holder.Equal(address) {
+		return ErrRoleAlreadyHolder
+	}
+
+	key, err := bc.stub.CreateCompositeKey(roleProposeKey, []string{role})
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&RoleHolder{Role: role, Proposed: address.String()})
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.SetEvent(RoleProposedEvent, data); err != nil {
+		return err
+	}
+	return bc.stub.PutState(key, address.Bytes())
+}
+
+// TxCancelRoleProposal cancels the proposal of the role, e.g. if the role is proposed
+// to a wrong address. Method is called by the admin, the current holder keeps the role
+func (bc *BaseContract) TxCancelRoleProposal(sender *types.Sender, role string) error {
+	if err := bc.checkAdmin(sender, ErrRoleAdminOnly); err != nil {
+		return err
+	}
+	if _, ok := roleArgPositions[role]; !ok {
+		return fmt.Errorf("%w: %s", ErrRoleUnknown, role)
+	}
+	proposed, err := bc.roleAddress(roleProposeKey, role)
+	if err != nil {
+		return err
+	}
+	if proposed == nil {
+		return ErrRoleNoProposal
+	}
+
+	key, err := bc.stub.CreateCompositeKey(roleProposeKey, []string{role})
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&RoleHolder{Role: role, Proposed: proposed.String()})
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.SetEvent(RoleProposalCancelledEvent, data); err != nil {
+		return err
+	}
+	return bc.stub.DelState(key)
+}
+
+// TxAcceptRole passes the role to the sender if the role is proposed to it
+func (bc *BaseContract) TxAcceptRole(sender *types.Sender, role string) error {
+	if _, ok := roleArgPositions[role]; !ok {
+		return fmt.Errorf("%w: %s", ErrRoleUnknown, role)
+	}
+	proposed, err := bc.roleAddress(roleProposeKey, role)
+	if err != nil {
+		return err
+	}
+	if proposed == nil || !sender.Equal(proposed) {
+		return ErrRoleNotProposed
+	}
+
+	proposeKey, err := bc.stub.CreateCompositeKey(roleProposeKey, []string{role})
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.DelState(proposeKey); err != nil {
+		return err
+	}
+	key, err := bc.stub.CreateCompositeKey(roleKey, []string{role})
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&RoleHolder{Role: role, Address: proposed.String()})
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.SetEvent(RoleAcceptedEvent, data); err != nil {
+		return err
+	}
+	return bc.stub.PutState(key, proposed.Bytes())
+}
+
+// QueryRoles returns current holders of roles and proposed ones
+func (bc *BaseContract) QueryRoles() ([]*RoleHolder, error) {
+	result := make([]*RoleHolder, 0, len(roles))
+	for _, role := range roles {
+		holder := &RoleHolder{Role: role}
+		addr, err := bc.GetRoleHolder(role)
+		if err != nil && !errors.Is(err, ErrRoleNotSet) {
+			return nil, err
+		}
+		if addr != nil {
+			holder.Address = addr.String()
+		}
+		proposed, err := bc.roleAddress(roleProposeKey, role)
+		if err != nil {
+			return nil, err
+		}
+		if proposed != nil {
+			holder.Proposed = proposed.String()
+		}
+		result = append(result, holder)
+	}
+	return result, nil
+}
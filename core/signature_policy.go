@@ -0,0 +1,80 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// ErrSignaturePolicyNotSatisfied is returned when weight of valid signatures is less than policy threshold
+var ErrSignaturePolicyNotSatisfied = errors.New("signature policy isn't satisfied")
+
+// signaturePolicy accumulates weights of valid signatures according to the ACL signature policy.
+// Every key has weight 1 unless another weight is set in the policy. The threshold is taken from
+// the policy (N is used if threshold isn't set), if there is no policy every signer has to sign.
+// Keys from the required list must sign regardless of the collected weight
+type signaturePolicy struct {
+	threshold uint64
+	collected uint64
+	weights   map[string]uint64
+	required  [][]byte
+	signed    map[string]struct{}
+}
+
+func newSignaturePolicy(policy *pb.SignaturePolicy, signers int) *signaturePolicy {
+	sp := &signaturePolicy{
+		threshold: 1, // for single sign
+		weights:   make(map[string]uint64),
+		signed:    make(map[string]struct{}),
+	}
+	if signers <= 1 {
+		return sp
+	}
+
+	if policy == nil {
+		sp.threshold = uint64(signers) // If it's not in the acl, everyone has to sign
+		return sp
+	}
+
+	sp.threshold = uint64(policy.N)
+	if policy.Threshold != 0 {
+		sp.threshold = uint64(policy.Threshold)
+	}
+	for _, wk := range policy.WeightedKeys {
+		sp.weights[string(wk.PubKey)] = uint64(wk.Weight)
+	}
+	sp.required = policy.RequiredPubKeys
+
+	return sp
+}
+
+// sign adds weight of the key whose signature has been verified, every key is counted once
+func (sp *signaturePolicy) sign(key []byte) {
+	if _, ok := sp.signed[string(key)]; ok {
+		return
+	}
+	sp.signed[string(key)] = struct{}{}
+
+	weight, ok := sp.weights[string(key)]
+	if !ok {
+		weight = 1
+	}
+	sp.collected += weight
+}
+
+// check returns an error if a required key hasn't signed or the threshold isn't reached,
+// required keys are checked in the order of the policy
+func (sp *signaturePolicy) check() error {
+	for _, key := range sp.required {
+		if _, ok := sp.signed[string(key)]; !ok {
+			return fmt.Errorf("%w: required signer %s hasn't signed",
+				ErrSignaturePolicyNotSatisfied, base58.Encode(key))
+		}
+	}
+	if sp.collected < sp.threshold {
+		return ErrSignaturePolicyNotSatisfied
+	}
+	return nil
+}
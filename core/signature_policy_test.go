@@ -0,0 +1,81 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignaturePolicy(t *testing.T) {
+	cfo, k1, k2, k3 := []byte("cfo"), []byte("k1"), []byte("k2"), []byte("k3")
+
+	testCases := []struct {
+		name    string
+		policy  *pb.SignaturePolicy
+		signers int
+		signed  [][]byte
+		wantErr bool
+	}{
+		{"single sign", nil, 1, [][]byte{k1}, false},
+		{"no policy, all signed", nil, 2, [][]byte{k1, k2}, false},
+		{"no policy, not all signed", nil, 2, [][]byte{k1}, true},
+		{"n of m", &pb.SignaturePolicy{N: 2}, 3, [][]byte{k1, k3}, false},
+		{"same key twice", &pb.SignaturePolicy{N: 2}, 3, [][]byte{k1, k1}, true},
+		{
+			"weighted threshold reached",
+			&pb.SignaturePolicy{N: 3, Threshold: 3, WeightedKeys: []*pb.WeightedKey{{PubKey: k1, Weight: 2}}},
+			3, [][]byte{k1, k2}, false,
+		},
+		{
+			"zero weight key",
+			&pb.SignaturePolicy{N: 3, Threshold: 2, WeightedKeys: []*pb.WeightedKey{{PubKey: k1, Weight: 0}}},
+			3, [][]byte{k1, k2}, true,
+		},
+		{
+			"required signer and any two",
+			&pb.SignaturePolicy{N: 4, Threshold: 3, RequiredPubKeys: [][]byte{cfo}},
+			4, [][]byte{cfo, k2, k3}, false,
+		},
+		{
+			"required signer is missing",
+			&pb.SignaturePolicy{N: 4, Threshold: 3, RequiredPubKeys: [][]byte{cfo}},
+			4, [][]byte{k1, k2, k3}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sp := newSignaturePolicy(tc.policy, tc.signers)
+			for _, key := range tc.signed {
+				sp.sign(key)
+			}
+			err := sp.check()
+			if tc.wantErr {
+				assert.True(t, errors.Is(err, ErrSignaturePolicyNotSatisfied))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSignaturePolicyRequiredOrder(t *testing.T) {
+	cfo, ceo, k1 := []byte("cfo"), []byte("ceo"), []byte("k1")
+	policy := &pb.SignaturePolicy{N: 3, Threshold: 1, RequiredPubKeys: [][]byte{cfo, ceo}}
+
+	// the first missing required key of the policy is reported every time
+	for i := 0; i < 10; i++ {
+		sp := newSignaturePolicy(policy, 3)
+		sp.sign(k1)
+		assert.ErrorContains(t, sp.check(), base58.Encode(cfo))
+	}
+
+	sp := newSignaturePolicy(policy, 3)
+	sp.sign(cfo)
+	assert.ErrorContains(t, sp.check(), base58.Encode(ceo))
+	sp.sign(ceo)
+	assert.NoError(t, sp.check())
+}
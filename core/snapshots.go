@@ -0,0 +1,226 @@
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+const (
+	snapshotIDKey    = "snapshotID"
+	snapshotKey      = "snapshot"
+	snapshotValueKey = "snapshotValue"
+)
+
+// snapshot errors
+var (
+	ErrSnapshotAdminOnly = errors.New("snapshots can be opened only by the admin")
+	ErrSnapshotNotFound  = errors.New("snapshot not found")
+	ErrSnapshotCorrupted = errors.New("snapshot value is corrupted")
+)
+
+// Snapshot describes an opened snapshot. Values of a snapshot are the values
+// at the moment it was opened
+type Snapshot struct {
+	ID        uint64 `json:"id"`
+	TxID      string `json:"txID"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// snapshotIDAttr encodes the snapshot id so that snapshots go in ascending order in range queries
+func snapshotIDAttr(id uint64) string {
+	return fmt.Sprintf("%020d", id)
+}
+
+func currentSnapshotID(stub shim.ChaincodeStubInterface) (uint64, error) {
+	data, err := stub.GetState(snapshotIDKey)
+	if err != nil || len(data) == 0 {
+		return 0, err
+	}
+	id, err := strconv.ParseUint(string(data), 10, 64) //nolint:gomnd
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrSnapshotCorrupted, err.Error())
+	}
+	return id, nil
+}
+
+// snapshotSave stores the value of the object under the current snapshot unless the value is already stored.
+// It must be called before the first mutation of the object after the snapshot was opened
+func snapshotSave(stub shim.ChaincodeStubInterface, objectType string, attrs []string, value *big.Int) error {
+	id, err := currentSnapshotID(stub)
+	if err != nil || id == 0 {
+		return err
+	}
+
+	key, err := stub.CreateCompositeKey(snapshotValueKey, snapshotValueAttrs(objectType, attrs, snapshotIDAttr(id)))
+	if err != nil {
+		return err
+	}
+	data, err := stub.GetState(key)
+	if err != nil || len(data) != 0 {
+		return err
+	}
+	// the decimal form is stored because an empty value can't be distinguished from a missing one
+	return stub.PutState(key, []byte(value.String()))
+}
+
+// snapshotBalance stores the balance under the current snapshot, only token and industrial balances are stored
+func snapshotBalance(stub shim.ChaincodeStubInterface, tokenType StateKey, addr *types.Address, path []string, balance *big.Int) error {
+	if tokenType != StateKeyTokenBalance {
+		return nil
+	}
+	return snapshotSave(stub, hex.EncodeToString([]byte{byte(tokenType)}), append([]string{addr.String()}, path...), balance)
+}
+
+func snapshotValueAttrs(objectType string, attrs []string, id ...string) []string {
+	res := make([]string, 0, len(attrs)+len(id)+1)
+	res = append(res, objectType)
+	res = append(res, attrs...)
+	return append(res, id...)
+}
+
+// valueAtSnapshot returns the value stored by the first mutation of the object after the snapshot was opened.
+// If the object wasn't changed since then, the current value is returned
+func valueAtSnapshot(stub shim.ChaincodeStubInterface, objectType string, attrs []string, snapshotID uint64, current *big.Int) (*big.Int, error) {
+	lastID, err := currentSnapshotID(stub)
+	if err != nil {
+		return nil, err
+	}
+	if snapshotID == 0 || snapshotID > lastID {
+		return nil, ErrSnapshotNotFound
+	}
+
+	iter, err := stub.GetStateByPartialCompositeKey(snapshotValueKey, snapshotValueAttrs(objectType, attrs))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	// values of the object go in ascending order of snapshots, keys of longer paths (e.g. industrial
+	// balances of the same address) are skipped
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		_, keyAttrs, err := stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		if len(keyAttrs) != len(attrs)+2 { //nolint:gomnd
+			continue
+		}
+		id, err := strconv.ParseUint(keyAttrs[len(keyAttrs)-1], 10, 64) //nolint:gomnd
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrSnapshotCorrupted, err.Error())
+		}
+		if id < snapshotID {
+			continue
+		}
+		value, ok := new(big.Int).SetString(string(kv.Value), 10) //nolint:gomnd
+		if !ok {
+			return nil, ErrSnapshotCorrupted
+		}
+		return value, nil
+	}
+	return current, nil
+}
+
+// SnapshotSave stores the value of the object under the current snapshot, it's used to take
+// snapshots of values which aren't balances, e.g. the total emission. It must be called before
+// every mutation of the object, only the first value after the snapshot was opened is stored
+func (bc *BaseContract) SnapshotSave(objectType string, attrs []string, value *big.Int) error {
+	return snapshotSave(bc.stub, objectType, attrs, value)
+}
+
+// ValueAtSnapshot returns the value of the object saved with SnapshotSave at the moment the snapshot was opened
+func (bc *BaseContract) ValueAtSnapshot(objectType string, attrs []string, snapshotID uint64, current *big.Int) (*big.Int, error) {
+	return valueAtSnapshot(bc.stub, objectType, attrs, snapshotID, current)
+}
+
+// TxOpenSnapshot opens the next snapshot, balances at the moment of the transaction are kept under it
+func (bc *BaseContract) TxOpenSnapshot(sender *types.Sender) error {
+	if err := bc.checkAdmin(sender, ErrSnapshotAdminOnly); err != nil {
+		return err
+	}
+
+	id, err := currentSnapshotID(bc.stub)
+	if err != nil {
+		return err
+	}
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+	snapshot := &Snapshot{ID: id + 1, TxID: bc.stub.GetTxID(), Timestamp: ts.Seconds}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	key, err := bc.stub.CreateCompositeKey(snapshotKey, []string{snapshotIDAttr(snapshot.ID)})
+	if err != nil {
+		return err
+	}
+	if err = bc.stub.PutState(key, data); err != nil {
+		return err
+	}
+	return bc.stub.PutState(snapshotIDKey, []byte(strconv.FormatUint(snapshot.ID, 10))) //nolint:gomnd
+}
+
+// QuerySnapshot returns the snapshot with the id, zero id means the last opened snapshot
+func (bc *BaseContract) QuerySnapshot(snapshotID uint64) (*Snapshot, error) {
+	if snapshotID == 0 {
+		id, err := currentSnapshotID(bc.stub)
+		if err != nil {
+			return nil, err
+		}
+		snapshotID = id
+	}
+
+	key, err := bc.stub.CreateCompositeKey(snapshotKey, []string{snapshotIDAttr(snapshotID)})
+	if err != nil {
+		return nil, err
+	}
+	data, err := bc.stub.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrSnapshotNotFound
+	}
+	snapshot := &Snapshot{}
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// QueryBalanceAtSnapshot returns the token balance of the address at the moment the snapshot was opened
+func (bc *BaseContract) QueryBalanceAtSnapshot(address *types.Address, snapshotID uint64) (*big.Int, error) {
+	_, balance, err := balanceGet(bc.stub, StateKeyTokenBalance, address)
+	if err != nil {
+		return nil, err
+	}
+	return valueAtSnapshot(bc.stub, hex.EncodeToString([]byte{byte(StateKeyTokenBalance)}), []string{address.String()}, snapshotID, balance)
+}
+
+// QueryIndustrialBalanceAtSnapshot returns the industrial balance of the address at the moment the snapshot was opened
+func (bc *BaseContract) QueryIndustrialBalanceAtSnapshot(address *types.Address, token string, snapshotID uint64) (*big.Int, error) {
+	parts := strings.Split(token, "_")
+	group := parts[len(parts)-1]
+	_, balance, err := balanceGet(bc.stub, StateKeyTokenBalance, address, group)
+	if err != nil {
+		return nil, err
+	}
+	return valueAtSnapshot(bc.stub, hex.EncodeToString([]byte{byte(StateKeyTokenBalance)}), []string{address.String(), group}, snapshotID, balance)
+}
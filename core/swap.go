@@ -59,8 +59,8 @@ func swapAnswer(stub *batchStub, swap *proto.Swap) (r *proto.SwapResponse) {
 	if _, err = SwapSave(txStub, hex.EncodeToString(swap.Id), swap); err != nil {
 		return &proto.SwapResponse{Id: swap.Id, Error: &proto.ResponseError{Error: err.Error()}}
 	}
-	writes, _ := txStub.Commit()
-	return &proto.SwapResponse{Id: swap.Id, Writes: writes}
+	writes, events := txStub.Commit()
+	return &proto.SwapResponse{Id: swap.Id, Writes: writes, Events: events}
 }
 
 func swapRobotDone(stub *batchStub, swapID []byte, key string) (r *proto.SwapResponse) {
@@ -89,8 +89,8 @@ func swapRobotDone(stub *batchStub, swapID []byte, key string) (r *proto.SwapRes
 	if err = SwapDel(txStub, hex.EncodeToString(swapID)); err != nil {
 		return &proto.SwapResponse{Id: swapID, Error: &proto.ResponseError{Error: err.Error()}}
 	}
-	writes, _ := txStub.Commit()
-	return &proto.SwapResponse{Id: swapID, Writes: writes}
+	writes, events := txStub.Commit()
+	return &proto.SwapResponse{Id: swapID, Writes: writes, Events: events}
 }
 
 func swapUserDone(bc BaseContractInterface, swapID string, key string) peer.Response {
@@ -111,7 +111,7 @@ func swapUserDone(bc BaseContractInterface, swapID string, key string) peer.Resp
 			return shim.Error(err.Error())
 		}
 	} else {
-		if err = bc.tokenBalanceAdd(types.AddrFromBytes(s.Owner), new(big.Int).SetBytes(s.Amount), s.Token); err != nil {
+		if err = bc.tokenBalanceAdd(types.AddrFromBytes(s.Owner), new(big.Int).SetBytes(s.Amount), s.Token, "swap"); err != nil {
 			return shim.Error(err.Error())
 		}
 	}
@@ -158,7 +158,7 @@ func (bc *BaseContract) TxSwapBegin(sender *types.Sender, token string, contract
 
 	switch {
 	case s.TokenSymbol() == s.From:
-		if err = bc.tokenBalanceSub(types.AddrFromBytes(s.Owner), amount, s.Token); err != nil {
+		if err = bc.tokenBalanceSub(types.AddrFromBytes(s.Owner), amount, s.Token, "swap"); err != nil {
 			return "", err
 		}
 	case s.TokenSymbol() == s.To:
@@ -205,7 +205,7 @@ func (bc *BaseContract) TxSwapCancel(_ *types.Sender, swapID string) error { //
 	// }
 	switch {
 	case bytes.Equal(s.Creator, s.Owner) && s.TokenSymbol() == s.From:
-		if err = bc.tokenBalanceAdd(types.AddrFromBytes(s.Owner), new(big.Int).SetBytes(s.Amount), s.Token); err != nil {
+		if err = bc.tokenBalanceAdd(types.AddrFromBytes(s.Owner), new(big.Int).SetBytes(s.Amount), s.Token, "swap"); err != nil {
 			return err
 		}
 	case bytes.Equal(s.Creator, s.Owner) && s.TokenSymbol() == s.To:
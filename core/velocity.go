@@ -0,0 +1,299 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+)
+
+// velocity windows
+const (
+	VelocityDay   = "day"
+	VelocityMonth = "month"
+)
+
+const (
+	velocityLimitKey        = "velocityLimit"
+	velocityAddressLimitKey = "velocityAddressLimit"
+	velocityUsageKey        = "velocityUsage"
+)
+
+// velocity errors
+var (
+	ErrVelocityAdminOnly = errors.New("velocity limits can be set only by the admin")
+	ErrVelocityWindow    = errors.New("velocity window must be day or month")
+	ErrVelocityLimit     = errors.New("velocity limit exceeded")
+	ErrVelocityAmount    = errors.New("velocity limit amount must not be negative")
+)
+
+// velocityWindow is a rolling window, usage is kept in buckets so that the window moves
+// with the granularity of the bucket
+type velocityWindow struct {
+	name   string
+	length int64
+	bucket int64
+}
+
+// velocityWindows are checked in this order
+var velocityWindows = []velocityWindow{
+	{name: VelocityDay, length: 24 * 60 * 60, bucket: 60 * 60},             //nolint:gomnd
+	{name: VelocityMonth, length: 30 * 24 * 60 * 60, bucket: 24 * 60 * 60}, //nolint:gomnd
+}
+
+// VelocityLimit limits the amount and the number of transfers of the token from an address
+// within the rolling window, zero means no limit
+type VelocityLimit struct {
+	Token     string `json:"token"`
+	Window    string `json:"window"`
+	Address   string `json:"address,omitempty"`
+	MaxAmount string `json:"maxAmount"`
+	MaxCount  uint64 `json:"maxCount"`
+}
+
+// VelocityHeadroom is the usage of the limit by the address and what remains of it
+type VelocityHeadroom struct {
+	VelocityLimit
+	Amount          string `json:"amount"`
+	Count           uint64 `json:"count"`
+	RemainingAmount string `json:"remainingAmount"`
+	RemainingCount  uint64 `json:"remainingCount"`
+}
+
+type velocityBucket struct {
+	Start  int64  `json:"start"`
+	Amount string `json:"amount"`
+	Count  uint64 `json:"count"`
+}
+
+type velocityUsage struct {
+	Buckets []*velocityBucket `json:"buckets"`
+}
+
+func velocityWindowByName(name string) (velocityWindow, error) {
+	for _, w := range velocityWindows {
+		if w.name == name {
+			return w, nil
+		}
+	}
+	return velocityWindow{}, ErrVelocityWindow
+}
+
+// TxSetVelocityLimit sets the limit of the token for every address which doesn't have its own limit,
+// method is called by the chaincode admin. Zero amount and count delete the limit
+func (bc *BaseContract) TxSetVelocityLimit(sender *types.Sender, token string, window string, maxAmount *big.Int, maxCount uint64) error {
+	return bc.setVelocityLimit(sender, &VelocityLimit{Token: token, Window: window, MaxCount: maxCount}, maxAmount)
+}
+
+// TxSetAddressVelocityLimit sets the limit of the token for the address, it overrides the limit of the token,
+// method is called by the chaincode admin. Zero amount and count delete the limit
+func (bc *BaseContract) TxSetAddressVelocityLimit(sender *types.Sender, address *types.Address, token string, window string, maxAmount *big.Int, maxCount uint64) error {
+	return bc.setVelocityLimit(sender, &VelocityLimit{Token: token, Window: window, Address: address.String(), MaxCount: maxCount}, maxAmount)
+}
+
+func (bc *BaseContract) setVelocityLimit(sender *types.Sender, limit *VelocityLimit, maxAmount *big.Int) error {
+	if err := bc.checkAdmin(sender, ErrVelocityAdminOnly); err != nil {
+		return err
+	}
+	if limit.Token == "" {
+		return ErrTokenTickerRequired
+	}
+	if _, err := velocityWindowByName(limit.Window); err != nil {
+		return err
+	}
+	if maxAmount == nil || maxAmount.Sign() < 0 {
+		return ErrVelocityAmount
+	}
+	limit.MaxAmount = maxAmount.String()
+
+	key, err := bc.velocityLimitKey(limit.Token, limit.Window, limit.Address)
+	if err != nil {
+		return err
+	}
+	if limit.MaxAmount == "0" && limit.MaxCount == 0 {
+		return bc.stub.DelState(key)
+	}
+	data, err := json.Marshal(limit)
+	if err != nil {
+		return err
+	}
+	return bc.stub.PutState(key, data)
+}
+
+func (bc *BaseContract) velocityLimitKey(token string, window string, address string) (string, error) {
+	if address == "" {
+		return bc.stub.CreateCompositeKey(velocityLimitKey, []string{token, window})
+	}
+	return bc.stub.CreateCompositeKey(velocityAddressLimitKey, []string{token, window, address})
+}
+
+// velocityLimit returns the limit of the address or of the token, nil if there is no limit
+func (bc *BaseContract) velocityLimit(address string, token string, window string) (*VelocityLimit, error) {
+	for _, addr := range []string{address, ""} {
+		key, err := bc.velocityLimitKey(token, window, addr)
+		if err != nil {
+			return nil, err
+		}
+		data, err := bc.stub.GetState(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 {
+			continue
+		}
+		limit := &VelocityLimit{}
+		if err = json.Unmarshal(data, limit); err != nil {
+			return nil, err
+		}
+		return limit, nil
+	}
+	return nil, nil
+}
+
+// velocityUsage returns buckets of the window which are still in it at the time now and their totals
+func (bc *BaseContract) velocityUsage(address string, token string, w velocityWindow, now int64) (string, *velocityUsage, *big.Int, uint64, error) {
+	key, err := bc.stub.CreateCompositeKey(velocityUsageKey, []string{address, token, w.name})
+	if err != nil {
+		return "", nil, nil, 0, err
+	}
+	data, err := bc.stub.GetState(key)
+	if err != nil {
+		return "", nil, nil, 0, err
+	}
+	usage := &velocityUsage{}
+	if len(data) != 0 {
+		if err = json.Unmarshal(data, usage); err != nil {
+			return "", nil, nil, 0, err
+		}
+	}
+
+	amount := new(big.Int)
+	var count uint64
+	buckets := make([]*velocityBucket, 0, len(usage.Buckets))
+	for _, b := range usage.Buckets {
+		if b.Start+w.bucket <= now-w.length {
+			continue
+		}
+		value, ok := new(big.Int).SetString(b.Amount, 10) //nolint:gomnd
+		if !ok {
+			return "", nil, nil, 0, ErrBigIntFromString
+		}
+		amount.Add(amount, value)
+		count += b.Count
+		buckets = append(buckets, b)
+	}
+	usage.Buckets = buckets
+	return key, usage, amount, count, nil
+}
+
+// ChargeVelocity checks limits of the token for the address and counts the transfer of the amount in them.
+// Nothing is written if the token has no limits
+func (bc *BaseContract) ChargeVelocity(address *types.Address, token string, amount *big.Int) error {
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+	now := ts.Seconds
+
+	type charge struct {
+		key   string
+		usage *velocityUsage
+		w     velocityWindow
+	}
+	charges := make([]charge, 0, len(velocityWindows))
+	for _, w := range velocityWindows {
+		limit, err := bc.velocityLimit(address.String(), token, w.name)
+		if err != nil {
+			return err
+		}
+		if limit == nil {
+			continue
+		}
+
+		key, usage, spent, count, err := bc.velocityUsage(address.String(), token, w, now)
+		if err != nil {
+			return err
+		}
+		maxAmount, ok := new(big.Int).SetString(limit.MaxAmount, 10) //nolint:gomnd
+		if !ok {
+			return ErrBigIntFromString
+		}
+		if maxAmount.Sign() > 0 && new(big.Int).Add(spent, amount).Cmp(maxAmount) > 0 {
+			return fmt.Errorf("%w: %s amount of %s", ErrVelocityLimit, w.name, token)
+		}
+		if limit.MaxCount > 0 && count+1 > limit.MaxCount {
+			return fmt.Errorf("%w: %s number of transfers of %s", ErrVelocityLimit, w.name, token)
+		}
+		charges = append(charges, charge{key: key, usage: usage, w: w})
+	}
+
+	// usage is written after all windows are checked
+	for _, c := range charges {
+		start := now - now%c.w.bucket
+		var bucket *velocityBucket
+		if n := len(c.usage.Buckets); n != 0 && c.usage.Buckets[n-1].Start == start {
+			bucket = c.usage.Buckets[n-1]
+		} else {
+			bucket = &velocityBucket{Start: start, Amount: "0"}
+			c.usage.Buckets = append(c.usage.Buckets, bucket)
+		}
+		value, ok := new(big.Int).SetString(bucket.Amount, 10) //nolint:gomnd
+		if !ok {
+			return ErrBigIntFromString
+		}
+		bucket.Amount = new(big.Int).Add(value, amount).String()
+		bucket.Count++
+
+		data, err := json.Marshal(c.usage)
+		if err != nil {
+			return err
+		}
+		if err = bc.stub.PutState(c.key, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QueryVelocityHeadroom returns limits of the token for the address with their usage and what remains of them
+func (bc *BaseContract) QueryVelocityHeadroom(address *types.Address, token string) ([]*VelocityHeadroom, error) {
+	ts, err := bc.stub.GetTxTimestamp()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*VelocityHeadroom{}
+	for _, w := range velocityWindows {
+		limit, err := bc.velocityLimit(address.String(), token, w.name)
+		if err != nil {
+			return nil, err
+		}
+		if limit == nil {
+			continue
+		}
+		_, _, spent, count, err := bc.velocityUsage(address.String(), token, w, ts.Seconds)
+		if err != nil {
+			return nil, err
+		}
+
+		headroom := &VelocityHeadroom{VelocityLimit: *limit, Amount: spent.String(), Count: count}
+		maxAmount, ok := new(big.Int).SetString(limit.MaxAmount, 10) //nolint:gomnd
+		if !ok {
+			return nil, ErrBigIntFromString
+		}
+		if maxAmount.Sign() > 0 {
+			remaining := new(big.Int).Sub(maxAmount, spent)
+			if remaining.Sign() < 0 {
+				remaining = new(big.Int)
+			}
+			headroom.RemainingAmount = remaining.String()
+		}
+		if limit.MaxCount > count {
+			headroom.RemainingCount = limit.MaxCount - count
+		}
+		result = append(result, headroom)
+	}
+	return result, nil
+}
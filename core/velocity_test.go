@@ -0,0 +1,30 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetVelocityLimitAmount(t *testing.T) {
+	admin := &types.Address{Address: make([]byte, 32)}
+	admin.Address[0] = 1
+	user := &types.Address{Address: make([]byte, 32)}
+	user.Address[0] = 2
+
+	bc := &BaseContract{id: "CC"}
+	bc.setStubAndInitArgs(newBalanceEventStub(newPeerStub()), nil, []string{admin.String()}, contractSettings{})
+	sender := types.NewSenderFromAddr(admin)
+
+	// arguments of the chaincode can't be negative, but contracts can call the methods directly
+	assert.ErrorIs(t, bc.TxSetVelocityLimit(sender, "CC", VelocityDay, big.NewInt(-100), 0), ErrVelocityAmount)
+	assert.ErrorIs(t, bc.TxSetAddressVelocityLimit(sender, user, "CC", VelocityDay, big.NewInt(-1), 5), ErrVelocityAmount)
+	assert.ErrorIs(t, bc.TxSetVelocityLimit(sender, "CC", VelocityDay, nil, 5), ErrVelocityAmount)
+
+	assert.NoError(t, bc.TxSetVelocityLimit(sender, "CC", VelocityDay, big.NewInt(0), 5))
+	limit, err := bc.velocityLimit(user.String(), "CC", VelocityDay)
+	assert.NoError(t, err)
+	assert.Equal(t, &VelocityLimit{Token: "CC", Window: VelocityDay, MaxAmount: "0", MaxCount: 5}, limit)
+}
@@ -68,6 +68,7 @@ type Ledger struct {
 	txResponseEvents    map[string]chan TxResponse
 	txResponseEventLock *sync.Mutex
 	batchPrefix         string
+	lastEvents          map[string]*peer.ChaincodeEvent
 }
 
 // GetStubByKey returns stub by key
@@ -104,6 +105,7 @@ func NewLedger(t *testing.T, options ...string) *Ledger {
 		stubs:               map[string]*stub.Stub{"acl": aclStub},
 		keyEvents:           make(map[string]chan *peer.ChaincodeEvent),
 		txResponseEvents:    make(map[string]chan TxResponse),
+		lastEvents:          make(map[string]*peer.ChaincodeEvent),
 		txResponseEventLock: &sync.Mutex{},
 		batchPrefix:         prefix,
 	}
@@ -127,17 +129,36 @@ const batchRobotCertHash = "380499dcb3d3ee374ccfd74cbdcbe03a1cd5ae66b282e5673dcb
 
 // NewChainCode creates new chaincode
 func (ledger *Ledger) NewChainCode(name string, bci core.BaseContractInterface, options *core.ContractOptions, fs *embed.FS, initArgs ...string) string {
+	return ledger.NewChainCodeWithOptions(name, bci, options, []core.ChaincodeOption{core.WithSrcFS(fs)}, initArgs...)
+}
+
+// NewChainCodeWithOptions creates new chaincode with chaincode options (ACL provider, src fs etc.)
+func (ledger *Ledger) NewChainCodeWithOptions(name string, bci core.BaseContractInterface, options *core.ContractOptions, chOptions []core.ChaincodeOption, initArgs ...string) string {
+	args := [][]byte{[]byte(""), []byte(batchRobotCertHash)}
+	for _, arg := range initArgs {
+		args = append(args, []byte(arg))
+	}
+	return ledger.newChainCode(name, bci, options, chOptions, args)
+}
+
+// NewChainCodeWithInitConfig creates new chaincode initialized with the named JSON config instead of positional args,
+// atomyzeSKI and robotSKI are set to values of the mock
+func (ledger *Ledger) NewChainCodeWithInitConfig(name string, bci core.BaseContractInterface, options *core.ContractOptions, config map[string]interface{}) string {
+	config["atomyzeSKI"] = ""
+	config["robotSKI"] = batchRobotCertHash
+	data, err := json.Marshal(config)
+	assert.NoError(ledger.t, err)
+	return ledger.newChainCode(name, bci, options, nil, [][]byte{data})
+}
+
+func (ledger *Ledger) newChainCode(name string, bci core.BaseContractInterface, options *core.ContractOptions, chOptions []core.ChaincodeOption, args [][]byte) string {
 	_, exists := ledger.stubs[name]
 	assert.False(ledger.t, exists)
-	cc, err := core.NewCC(bci, options, core.WithSrcFS(fs))
+	cc, err := core.NewCC(bci, options, chOptions...)
 	assert.NoError(ledger.t, err)
 	ledger.stubs[name] = stub.NewMockStub(name, cc)
 	ledger.stubs[name].ChannelID = name
 	ledger.stubs[name].MockPeerChaincode("acl/acl", ledger.stubs["acl"])
-	args := [][]byte{[]byte(""), []byte(batchRobotCertHash)}
-	for _, arg := range initArgs {
-		args = append(args, []byte(arg))
-	}
 	cert, err := base64.StdEncoding.DecodeString(adminCert)
 	assert.NoError(ledger.t, err)
 	_ = ledger.stubs[name].SetCreatorCert("atomyzeMSP", cert)
@@ -151,6 +172,16 @@ func (ledger *Ledger) NewChainCode(name string, bci core.BaseContractInterface,
 	return ""
 }
 
+// UpgradeChainCode replaces the chaincode of the existing channel with the new contract and options,
+// the state of the channel is kept
+func (ledger *Ledger) UpgradeChainCode(name string, bci core.BaseContractInterface, options *core.ContractOptions, chOptions ...core.ChaincodeOption) {
+	_, exists := ledger.stubs[name]
+	assert.True(ledger.t, exists)
+	cc, err := core.NewCC(bci, options, chOptions...)
+	assert.NoError(ledger.t, err)
+	ledger.stubs[name].SetChaincode(cc)
+}
+
 // GetStub returns stub
 func (ledger *Ledger) GetStub(name string) *stub.Stub {
 	return ledger.stubs[name]
@@ -307,13 +338,41 @@ func (ledger *Ledger) doInvoke(ch string, txID string, fn string, args ...string
 	result := ledger.stubs[ch].MockInvokeWithSignedProposal(txID, vArgs, &peer.SignedProposal{
 		ProposalBytes: proposal,
 	})
+	ledger.collectEvent(ch, fn)
 	assert.Equal(ledger.t, int32(200), result.Status, result.Message) //nolint:gomnd
 	return string(result.Payload)
 }
 
+// collectEvent takes the event of the transaction executed without batch from the events channel,
+// so the channel keeps only batchExecute events which are read by batch helpers
+func (ledger *Ledger) collectEvent(ch string, fn string) {
+	if fn == batchExecute {
+		return
+	}
+	delete(ledger.lastEvents, ch)
+	for {
+		select {
+		case e := <-ledger.stubs[ch].ChaincodeEventsChannel:
+			ledger.lastEvents[ch] = e
+		default:
+			return
+		}
+	}
+}
+
+// LastEvent returns the event of the last transaction executed without batch in the channel
+func (ledger *Ledger) LastEvent(ch string) *peer.ChaincodeEvent {
+	return ledger.lastEvents[ch]
+}
+
 func (ledger *Ledger) doInvokeWithErrorReturned(ch string, txID string, fn string, args ...string) error {
+	_, err := ledger.doInvokeWithResultReturned(ch, txID, fn, args...)
+	return err
+}
+
+func (ledger *Ledger) doInvokeWithResultReturned(ch string, txID string, fn string, args ...string) (string, error) {
 	if err := ledger.verifyIncoming(ch, fn); err != nil {
-		return err
+		return "", err
 	}
 	vArgs := make([][]byte, len(args)+1)
 	vArgs[0] = []byte(fn)
@@ -344,10 +403,11 @@ func (ledger *Ledger) doInvokeWithErrorReturned(ch string, txID string, fn strin
 	result := ledger.stubs[ch].MockInvokeWithSignedProposal(txID, vArgs, &peer.SignedProposal{
 		ProposalBytes: proposal,
 	})
+	ledger.collectEvent(ch, fn)
 	if result.Status != 200 { //nolint:gomnd
-		return errors.New(result.Message)
+		return "", errors.New(result.Message)
 	}
-	return nil
+	return string(result.Payload), nil
 }
 
 // Metadata struct
@@ -17,7 +17,10 @@ import (
 	"golang.org/x/crypto/sha3"
 )
 
-const rightKey = "acl_access_matrix"
+const (
+	rightKey           = "acl_access_matrix"
+	signaturePolicyKey = "acl_signature_policy"
+)
 
 // mockACL emulates alc chaincode, rights are stored in state
 type mockACL struct{}
@@ -51,16 +54,18 @@ func (ma *mockACL) Invoke(stub shim.ChaincodeStubInterface) peer.Response { //no
 		})
 
 		hashed := sha3.Sum256(bytes.Join(binPubKeys, []byte("")))
+		policy, err := ma.getSignaturePolicy(stub, base58.CheckEncode(hashed[1:], hashed[0]))
+		if err != nil {
+			return shim.Error(err.Error())
+		}
 		data, err := proto.Marshal(&pb.AclResponse{
 			Account: &pb.AccountInfo{
 				KycHash:    "123",
 				GrayListed: false,
 			},
 			Address: &pb.SignedAddress{
-				Address: &pb.Address{Address: hashed[:]},
-				SignaturePolicy: &pb.SignaturePolicy{
-					N: 2, //nolint:gomnd
-				},
+				Address:         &pb.Address{Address: hashed[:]},
+				SignaturePolicy: policy,
 			},
 		})
 		if err != nil {
@@ -245,3 +250,27 @@ func (ma mockACL) getRight(stub shim.ChaincodeStubInterface, channel, cc, role,
 
 	return false, nil
 }
+
+// getSignaturePolicy returns signature policy stored for the address or default policy "2 of N"
+func (ma mockACL) getSignaturePolicy(stub shim.ChaincodeStubInterface, addr string) (*pb.SignaturePolicy, error) {
+	key, err := stub.CreateCompositeKey(signaturePolicyKey, []string{addr})
+	if err != nil {
+		return nil, err
+	}
+
+	rawPolicy, err := stub.GetState(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(rawPolicy) == 0 {
+		return &pb.SignaturePolicy{
+			N: 2, //nolint:gomnd
+		}, nil
+	}
+
+	policy := &pb.SignaturePolicy{}
+	if err = proto.Unmarshal(rawPolicy, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
@@ -51,7 +51,37 @@ func (w *Multisig) ChangeKeysFor(index int, sKey ed25519.PrivateKey) error {
 	return nil
 }
 
-func (w *Multisig) sign(signCnt int, fn string, ch string, args ...string) ([]string, string) {
+// SetSignaturePolicy stores weighted signature policy of the multisig wallet in the mock ACL.
+// weights are set for keys in the PubKeys order (missing weights are 1),
+// required contains indexes of keys which must always sign
+func (w *Multisig) SetSignaturePolicy(threshold uint32, weights []uint32, required ...int) {
+	policy := &proto.SignaturePolicy{
+		N:         uint32(len(w.pKeys)),
+		Threshold: threshold,
+	}
+	for i, weight := range weights {
+		policy.WeightedKeys = append(policy.WeightedKeys, &proto.WeightedKey{
+			PubKey: w.pKeys[i],
+			Weight: weight,
+		})
+	}
+	for _, i := range required {
+		policy.RequiredPubKeys = append(policy.RequiredPubKeys, w.pKeys[i])
+	}
+
+	data, err := pb.Marshal(policy)
+	assert.NoError(w.ledger.t, err)
+
+	aclStub := w.ledger.stubs["acl"]
+	key, err := aclStub.CreateCompositeKey(signaturePolicyKey, []string{w.addr})
+	assert.NoError(w.ledger.t, err)
+	txID := txIDGen()
+	aclStub.MockTransactionStart(txID)
+	assert.NoError(w.ledger.t, aclStub.PutState(key, data))
+	aclStub.MockTransactionEnd(txID)
+}
+
+func (w *Multisig) sign(signers []int, fn string, ch string, args ...string) ([]string, string) {
 	time.Sleep(time.Millisecond * 5) //nolint:gomnd
 	nonce := strconv.FormatInt(time.Now().UnixNano()/1000000, 10)
 	result := append(append([]string{fn, "", ch, ch}, args...), nonce)
@@ -59,24 +89,53 @@ func (w *Multisig) sign(signCnt int, fn string, ch string, args ...string) ([]st
 		result = append(result, base58.Encode(pk))
 	}
 	message := sha3.Sum256([]byte(strings.Join(result, "")))
-	for _, skey := range w.sKeys {
-		if signCnt > 0 {
-			result = append(result, base58.Encode(ed25519.Sign(skey, message[:])))
-		} else {
-			result = append(result, "")
-		}
-		signCnt--
+
+	signs := make([]string, len(w.sKeys))
+	for _, i := range signers {
+		signs[i] = base58.Encode(ed25519.Sign(w.sKeys[i], message[:]))
 	}
+	result = append(result, signs...)
 
 	return result[1:], hex.EncodeToString(message[:])
 }
 
-// RawSignedInvoke invokes chaincode function with specific arguments and signs it with multisig wallet
+// RawSignedInvoke invokes chaincode function with specific arguments and signs it with first signCnt keys of multisig wallet
 func (w *Multisig) RawSignedInvoke(signCnt int, ch string, fn string, args ...string) (string, TxResponse, []*proto.Swap) {
+	signers := make([]int, 0, signCnt)
+	for i := 0; i < signCnt && i < len(w.sKeys); i++ {
+		signers = append(signers, i)
+	}
+	return w.RawSignedInvokeBy(signers, ch, fn, args...)
+}
+
+// RawSignedInvokeBy invokes chaincode function with specific arguments and signs it
+// with keys of multisig wallet with the given indexes
+func (w *Multisig) RawSignedInvokeBy(signers []int, ch string, fn string, args ...string) (string, TxResponse, []*proto.Swap) {
 	txID := txIDGen()
-	args, _ = w.sign(signCnt, fn, ch, args...)
+	args, _ = w.sign(signers, fn, ch, args...)
 	w.ledger.doInvoke(ch, txID, fn, args...)
 
+	res, swaps := w.executeBatch(ch, txID)
+	return txID, res, swaps
+}
+
+// RawSignedInvokeByWithErrorReturned invokes chaincode function with specific arguments, signs it
+// with keys of multisig wallet with the given indexes and returns an error of the transaction
+func (w *Multisig) RawSignedInvokeByWithErrorReturned(signers []int, ch string, fn string, args ...string) error {
+	txID := txIDGen()
+	args, _ = w.sign(signers, fn, ch, args...)
+	if err := w.ledger.doInvokeWithErrorReturned(ch, txID, fn, args...); err != nil {
+		return err
+	}
+
+	res, _ := w.executeBatch(ch, txID)
+	if res.Error != "" {
+		return errors.New(res.Error)
+	}
+	return nil
+}
+
+func (w *Multisig) executeBatch(ch string, txID string) (TxResponse, []*proto.Swap) {
 	id, err := hex.DecodeString(txID)
 	assert.NoError(w.ledger.t, err)
 	data, err := pb.Marshal(&proto.Batch{TxIDs: [][]byte{id}})
@@ -103,7 +162,7 @@ func (w *Multisig) RawSignedInvoke(signCnt int, ch string, fn string, args ...st
 				if e.Error != nil {
 					err = e.Error.Error
 				}
-				return txID, TxResponse{
+				return TxResponse{
 					Method: e.Method,
 					Error:  err,
 					Result: string(e.Result),
@@ -113,7 +172,7 @@ func (w *Multisig) RawSignedInvoke(signCnt int, ch string, fn string, args ...st
 		}
 	}
 	assert.Fail(w.ledger.t, shouldNotBeHereMsg)
-	return txID, TxResponse{}, out.CreatedSwaps
+	return TxResponse{}, out.CreatedSwaps
 }
 
 // SecretKeys returns private keys of multisig wallet
@@ -53,6 +53,7 @@ type Stub struct {
 	ChaincodeEventsChannel chan *pb.ChaincodeEvent      // channel to store ChaincodeEvents
 	Decorations            map[string][]byte
 	creator                []byte
+	fixedTxTimestamp       *timestamp.Timestamp // timestamp of transactions set by SetTxTimestamp
 }
 
 // GetTxID returns the transaction ID for the current chaincode invocation request.
@@ -98,7 +99,11 @@ func (stub *Stub) GetFunctionAndParameters() (function string, params []string)
 func (stub *Stub) MockTransactionStart(txID string) {
 	stub.TxID = txID
 	stub.setSignedProposal(&pb.SignedProposal{})
-	stub.setTxTimestamp(createUtcTimestamp())
+	if stub.fixedTxTimestamp != nil {
+		stub.setTxTimestamp(stub.fixedTxTimestamp)
+	} else {
+		stub.setTxTimestamp(createUtcTimestamp())
+	}
 }
 
 // MockTransactionEnd ends a mocked transaction, clearing the UUID.
@@ -536,6 +541,12 @@ func (stub *Stub) setTxTimestamp(time *timestamp.Timestamp) {
 	stub.TxTimestamp = time
 }
 
+// SetTxTimestamp sets the timestamp of the next transactions, e.g. to check expiration.
+// Nil restores the current time
+func (stub *Stub) SetTxTimestamp(time *timestamp.Timestamp) {
+	stub.fixedTxTimestamp = time
+}
+
 // GetTxTimestamp returns timestamp.
 func (stub *Stub) GetTxTimestamp() (*timestamp.Timestamp, error) {
 	if stub.TxTimestamp == nil {
@@ -600,6 +611,11 @@ func NewMockStub(name string, cc shim.Chaincode) *Stub {
 	return s
 }
 
+// SetChaincode replaces the chaincode of the stub keeping its state, like a chaincode upgrade
+func (stub *Stub) SetChaincode(cc shim.Chaincode) {
+	stub.cc = cc
+}
+
 /*****************************
  Range Query Iterator
 *****************************/
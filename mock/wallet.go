@@ -575,6 +575,18 @@ func (w *Wallet) SignedMultiSwapsInvoke(ch string, fn string, args ...string) st
 	return txID
 }
 
+// SignedNbInvoke signs and invokes a function executed without batch (NBTx) and returns its result
+func (w *Wallet) SignedNbInvoke(ch string, fn string, args ...string) (string, error) {
+	if err := w.verifyIncoming(ch, fn); err != nil {
+		return "", err
+	}
+	message, _ := w.sign(fn, ch, args...)
+	cert, err := base64.StdEncoding.DecodeString(userCert)
+	assert.NoError(w.ledger.t, err)
+	_ = w.ledger.stubs[ch].SetCreatorCert("atomyzeMSP", cert)
+	return w.ledger.doInvokeWithResultReturned(ch, txIDGen(), fn, message...)
+}
+
 // OtfNbInvoke invokes a function on the ledger
 func (w *Wallet) OtfNbInvoke(ch string, fn string, args ...string) (string, string) {
 	if err := w.verifyIncoming(ch, fn); err != nil {
@@ -20,6 +20,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BalanceChange_Kind int32
+
+const (
+	BalanceChange_TOKEN             BalanceChange_Kind = 0
+	BalanceChange_ALLOWED           BalanceChange_Kind = 1
+	BalanceChange_LOCKED_TOKEN      BalanceChange_Kind = 2
+	BalanceChange_LOCKED_ALLOWED    BalanceChange_Kind = 3
+	BalanceChange_INDUSTRIAL        BalanceChange_Kind = 4
+	BalanceChange_LOCKED_INDUSTRIAL BalanceChange_Kind = 5
+	BalanceChange_GIVEN             BalanceChange_Kind = 6 // given balance of the contract, the address is empty
+)
+
+// Enum value maps for BalanceChange_Kind.
+var (
+	BalanceChange_Kind_name = map[int32]string{
+		0: "TOKEN",
+		1: "ALLOWED",
+		2: "LOCKED_TOKEN",
+		3: "LOCKED_ALLOWED",
+		4: "INDUSTRIAL",
+		5: "LOCKED_INDUSTRIAL",
+		6: "GIVEN",
+	}
+	BalanceChange_Kind_value = map[string]int32{
+		"TOKEN":             0,
+		"ALLOWED":           1,
+		"LOCKED_TOKEN":      2,
+		"LOCKED_ALLOWED":    3,
+		"INDUSTRIAL":        4,
+		"LOCKED_INDUSTRIAL": 5,
+		"GIVEN":             6,
+	}
+)
+
+func (x BalanceChange_Kind) Enum() *BalanceChange_Kind {
+	p := new(BalanceChange_Kind)
+	*p = x
+	return p
+}
+
+func (x BalanceChange_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BalanceChange_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_batch_proto_enumTypes[0].Descriptor()
+}
+
+func (BalanceChange_Kind) Type() protoreflect.EnumType {
+	return &file_batch_proto_enumTypes[0]
+}
+
+func (x BalanceChange_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BalanceChange_Kind.Descriptor instead.
+func (BalanceChange_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{41, 0}
+}
+
 type MultiSwap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -436,9 +497,11 @@ type InitArgs struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AtomyzeSKI []byte   `protobuf:"bytes,1,opt,name=atomyzeSKI,proto3" json:"atomyzeSKI,omitempty"`
-	RobotSKI   []byte   `protobuf:"bytes,2,opt,name=robotSKI,proto3" json:"robotSKI,omitempty"`
-	Args       []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	AtomyzeSKI []byte        `protobuf:"bytes,1,opt,name=atomyzeSKI,proto3" json:"atomyzeSKI,omitempty"`
+	RobotSKI   []byte        `protobuf:"bytes,2,opt,name=robotSKI,proto3" json:"robotSKI,omitempty"`
+	Args       []string      `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	ArgNames   []string      `protobuf:"bytes,4,rep,name=arg_names,json=argNames,proto3" json:"arg_names,omitempty"` // names of args from the init schema of the contract
+	Options    []*InitOption `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`                   // named options of the init config
 }
 
 func (x *InitArgs) Reset() {
@@ -494,6 +557,75 @@ func (x *InitArgs) GetArgs() []string {
 	return nil
 }
 
+func (x *InitArgs) GetArgNames() []string {
+	if x != nil {
+		return x.ArgNames
+	}
+	return nil
+}
+
+func (x *InitArgs) GetOptions() []*InitOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type InitOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *InitOption) Reset() {
+	*x = InitOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitOption) ProtoMessage() {}
+
+func (x *InitOption) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitOption.ProtoReflect.Descriptor instead.
+func (*InitOption) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InitOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InitOption) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type WriteElement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -507,7 +639,7 @@ type WriteElement struct {
 func (x *WriteElement) Reset() {
 	*x = WriteElement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[6]
+		mi := &file_batch_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -520,7 +652,7 @@ func (x *WriteElement) String() string {
 func (*WriteElement) ProtoMessage() {}
 
 func (x *WriteElement) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[6]
+	mi := &file_batch_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +665,7 @@ func (x *WriteElement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteElement.ProtoReflect.Descriptor instead.
 func (*WriteElement) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{6}
+	return file_batch_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WriteElement) GetKey() string {
@@ -569,7 +701,7 @@ type ResponseError struct {
 func (x *ResponseError) Reset() {
 	*x = ResponseError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[7]
+		mi := &file_batch_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -582,7 +714,7 @@ func (x *ResponseError) String() string {
 func (*ResponseError) ProtoMessage() {}
 
 func (x *ResponseError) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[7]
+	mi := &file_batch_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +727,7 @@ func (x *ResponseError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResponseError.ProtoReflect.Descriptor instead.
 func (*ResponseError) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{7}
+	return file_batch_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ResponseError) GetCode() int32 {
@@ -620,12 +752,13 @@ type SwapResponse struct {
 	Id     []byte          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Error  *ResponseError  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	Writes []*WriteElement `protobuf:"bytes,3,rep,name=writes,proto3" json:"writes,omitempty"`
+	Events []*Event        `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
 }
 
 func (x *SwapResponse) Reset() {
 	*x = SwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[8]
+		mi := &file_batch_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -638,7 +771,7 @@ func (x *SwapResponse) String() string {
 func (*SwapResponse) ProtoMessage() {}
 
 func (x *SwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[8]
+	mi := &file_batch_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,7 +784,7 @@ func (x *SwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwapResponse.ProtoReflect.Descriptor instead.
 func (*SwapResponse) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{8}
+	return file_batch_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SwapResponse) GetId() []byte {
@@ -675,6 +808,13 @@ func (x *SwapResponse) GetWrites() []*WriteElement {
 	return nil
 }
 
+func (x *SwapResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 type AccountingRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -690,7 +830,7 @@ type AccountingRecord struct {
 func (x *AccountingRecord) Reset() {
 	*x = AccountingRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[9]
+		mi := &file_batch_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -703,7 +843,7 @@ func (x *AccountingRecord) String() string {
 func (*AccountingRecord) ProtoMessage() {}
 
 func (x *AccountingRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[9]
+	mi := &file_batch_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +856,7 @@ func (x *AccountingRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountingRecord.ProtoReflect.Descriptor instead.
 func (*AccountingRecord) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{9}
+	return file_batch_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AccountingRecord) GetToken() string {
@@ -766,7 +906,7 @@ type Event struct {
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[10]
+		mi := &file_batch_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -779,7 +919,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[10]
+	mi := &file_batch_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +932,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{10}
+	return file_batch_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Event) GetName() string {
@@ -823,7 +963,7 @@ type TxResponse struct {
 func (x *TxResponse) Reset() {
 	*x = TxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[11]
+		mi := &file_batch_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -836,7 +976,7 @@ func (x *TxResponse) String() string {
 func (*TxResponse) ProtoMessage() {}
 
 func (x *TxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[11]
+	mi := &file_batch_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,7 +989,7 @@ func (x *TxResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxResponse.ProtoReflect.Descriptor instead.
 func (*TxResponse) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{11}
+	return file_batch_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TxResponse) GetId() []byte {
@@ -896,7 +1036,7 @@ type BatchTxEvent struct {
 func (x *BatchTxEvent) Reset() {
 	*x = BatchTxEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[12]
+		mi := &file_batch_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -909,7 +1049,7 @@ func (x *BatchTxEvent) String() string {
 func (*BatchTxEvent) ProtoMessage() {}
 
 func (x *BatchTxEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[12]
+	mi := &file_batch_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1062,7 @@ func (x *BatchTxEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchTxEvent.ProtoReflect.Descriptor instead.
 func (*BatchTxEvent) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{12}
+	return file_batch_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BatchTxEvent) GetId() []byte {
@@ -978,7 +1118,7 @@ type BatchEvent struct {
 func (x *BatchEvent) Reset() {
 	*x = BatchEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[13]
+		mi := &file_batch_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -991,7 +1131,7 @@ func (x *BatchEvent) String() string {
 func (*BatchEvent) ProtoMessage() {}
 
 func (x *BatchEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[13]
+	mi := &file_batch_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,7 +1144,7 @@ func (x *BatchEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchEvent.ProtoReflect.Descriptor instead.
 func (*BatchEvent) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{13}
+	return file_batch_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BatchEvent) GetEvents() []*BatchTxEvent {
@@ -1029,7 +1169,7 @@ type BatchResponse struct {
 func (x *BatchResponse) Reset() {
 	*x = BatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[14]
+		mi := &file_batch_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1042,7 +1182,7 @@ func (x *BatchResponse) String() string {
 func (*BatchResponse) ProtoMessage() {}
 
 func (x *BatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[14]
+	mi := &file_batch_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,7 +1195,7 @@ func (x *BatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
 func (*BatchResponse) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{14}
+	return file_batch_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BatchResponse) GetTxResponses() []*TxResponse {
@@ -1104,7 +1244,7 @@ type Nested struct {
 func (x *Nested) Reset() {
 	*x = Nested{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[15]
+		mi := &file_batch_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1117,7 +1257,7 @@ func (x *Nested) String() string {
 func (*Nested) ProtoMessage() {}
 
 func (x *Nested) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[15]
+	mi := &file_batch_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1270,7 @@ func (x *Nested) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Nested.ProtoReflect.Descriptor instead.
 func (*Nested) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{15}
+	return file_batch_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Nested) GetArgs() []string {
@@ -1154,7 +1294,7 @@ type TokenFee struct {
 func (x *TokenFee) Reset() {
 	*x = TokenFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[16]
+		mi := &file_batch_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1167,7 +1307,7 @@ func (x *TokenFee) String() string {
 func (*TokenFee) ProtoMessage() {}
 
 func (x *TokenFee) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[16]
+	mi := &file_batch_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,7 +1320,7 @@ func (x *TokenFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenFee.ProtoReflect.Descriptor instead.
 func (*TokenFee) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{16}
+	return file_batch_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TokenFee) GetCurrency() string {
@@ -1211,36 +1351,35 @@ func (x *TokenFee) GetCap() []byte {
 	return nil
 }
 
-type TokenRate struct {
+// FeeTier is a tier of the fee schedule, values are decimal strings
+type FeeTier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DealType string   `protobuf:"bytes,1,opt,name=deal_type,json=dealType,proto3" json:"deal_type,omitempty"`
-	Currency string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	Rate     []byte   `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
-	Min      []byte   `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
-	Max      []byte   `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
-	Issuer   *Address `protobuf:"bytes,6,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // the tier applies to amounts from this value
+	Fee  string `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`   // percentage with 8 decimals
+	Flat string `protobuf:"bytes,3,opt,name=flat,proto3" json:"flat,omitempty"` // flat fee in the fee currency added to the percentage
+	Cap  string `protobuf:"bytes,4,opt,name=cap,proto3" json:"cap,omitempty"`   // cap of the tier fee in the fee currency, empty or zero - no cap
 }
 
-func (x *TokenRate) Reset() {
-	*x = TokenRate{}
+func (x *FeeTier) Reset() {
+	*x = FeeTier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[17]
+		mi := &file_batch_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenRate) String() string {
+func (x *FeeTier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenRate) ProtoMessage() {}
+func (*FeeTier) ProtoMessage() {}
 
-func (x *TokenRate) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[17]
+func (x *FeeTier) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,81 +1390,67 @@ func (x *TokenRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenRate.ProtoReflect.Descriptor instead.
-func (*TokenRate) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use FeeTier.ProtoReflect.Descriptor instead.
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TokenRate) GetDealType() string {
+func (x *FeeTier) GetFrom() string {
 	if x != nil {
-		return x.DealType
+		return x.From
 	}
 	return ""
 }
 
-func (x *TokenRate) GetCurrency() string {
+func (x *FeeTier) GetFee() string {
 	if x != nil {
-		return x.Currency
+		return x.Fee
 	}
 	return ""
 }
 
-func (x *TokenRate) GetRate() []byte {
-	if x != nil {
-		return x.Rate
-	}
-	return nil
-}
-
-func (x *TokenRate) GetMin() []byte {
-	if x != nil {
-		return x.Min
-	}
-	return nil
-}
-
-func (x *TokenRate) GetMax() []byte {
+func (x *FeeTier) GetFlat() string {
 	if x != nil {
-		return x.Max
+		return x.Flat
 	}
-	return nil
+	return ""
 }
 
-func (x *TokenRate) GetIssuer() *Address {
+func (x *FeeTier) GetCap() string {
 	if x != nil {
-		return x.Issuer
+		return x.Cap
 	}
-	return nil
+	return ""
 }
 
-type Token struct {
+// FeeSchedule is the fee of an operation, the tier with the largest from not greater than the amount applies
+type FeeSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalEmission []byte       `protobuf:"bytes,1,opt,name=total_emission,json=totalEmission,proto3" json:"total_emission,omitempty"`
-	Fee           *TokenFee    `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
-	Rates         []*TokenRate `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"`
-	FeeAddress    []byte       `protobuf:"bytes,4,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	Operation string     `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Currency  string     `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Tiers     []*FeeTier `protobuf:"bytes,3,rep,name=tiers,proto3" json:"tiers,omitempty"` // in ascending order of from
 }
 
-func (x *Token) Reset() {
-	*x = Token{}
+func (x *FeeSchedule) Reset() {
+	*x = FeeSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[18]
+		mi := &file_batch_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Token) String() string {
+func (x *FeeSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Token) ProtoMessage() {}
+func (*FeeSchedule) ProtoMessage() {}
 
-func (x *Token) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[18]
+func (x *FeeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,64 +1461,59 @@ func (x *Token) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Token.ProtoReflect.Descriptor instead.
-func (*Token) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *Token) GetTotalEmission() []byte {
-	if x != nil {
-		return x.TotalEmission
-	}
-	return nil
+// Deprecated: Use FeeSchedule.ProtoReflect.Descriptor instead.
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Token) GetFee() *TokenFee {
+func (x *FeeSchedule) GetOperation() string {
 	if x != nil {
-		return x.Fee
+		return x.Operation
 	}
-	return nil
+	return ""
 }
 
-func (x *Token) GetRates() []*TokenRate {
+func (x *FeeSchedule) GetCurrency() string {
 	if x != nil {
-		return x.Rates
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-func (x *Token) GetFeeAddress() []byte {
+func (x *FeeSchedule) GetTiers() []*FeeTier {
 	if x != nil {
-		return x.FeeAddress
+		return x.Tiers
 	}
 	return nil
 }
 
-type HaveRight struct {
+// FeeRecipient receives the share of fees
+type FeeRecipient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HaveRight bool `protobuf:"varint,1,opt,name=haveRight,proto3" json:"haveRight,omitempty"`
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Share   uint32 `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"` // basis points, shares of all recipients sum up to 10000
 }
 
-func (x *HaveRight) Reset() {
-	*x = HaveRight{}
+func (x *FeeRecipient) Reset() {
+	*x = FeeRecipient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[19]
+		mi := &file_batch_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HaveRight) String() string {
+func (x *FeeRecipient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HaveRight) ProtoMessage() {}
+func (*FeeRecipient) ProtoMessage() {}
 
-func (x *HaveRight) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[19]
+func (x *FeeRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,48 +1524,57 @@ func (x *HaveRight) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HaveRight.ProtoReflect.Descriptor instead.
-func (*HaveRight) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use FeeRecipient.ProtoReflect.Descriptor instead.
+func (*FeeRecipient) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *HaveRight) GetHaveRight() bool {
+func (x *FeeRecipient) GetAddress() []byte {
 	if x != nil {
-		return x.HaveRight
+		return x.Address
 	}
-	return false
+	return nil
 }
 
-type Right struct {
+func (x *FeeRecipient) GetShare() uint32 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+type TokenRate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelName   string     `protobuf:"bytes,1,opt,name=channelName,proto3" json:"channelName,omitempty"`
-	ChaincodeName string     `protobuf:"bytes,2,opt,name=chaincodeName,proto3" json:"chaincodeName,omitempty"`
-	RoleName      string     `protobuf:"bytes,3,opt,name=roleName,proto3" json:"roleName,omitempty"`
-	OperationName string     `protobuf:"bytes,4,opt,name=operationName,proto3" json:"operationName,omitempty"`
-	Address       *Address   `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	HaveRight     *HaveRight `protobuf:"bytes,6,opt,name=haveRight,proto3" json:"haveRight,omitempty"`
+	DealType   string   `protobuf:"bytes,1,opt,name=deal_type,json=dealType,proto3" json:"deal_type,omitempty"`
+	Currency   string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Rate       []byte   `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Min        []byte   `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max        []byte   `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
+	Issuer     *Address `protobuf:"bytes,6,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ValidFrom  int64    `protobuf:"varint,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`    // unix time in seconds, zero - valid from the time it's set
+	ValidUntil int64    `protobuf:"varint,8,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"` // unix time in seconds, zero - valid until it's changed
 }
 
-func (x *Right) Reset() {
-	*x = Right{}
+func (x *TokenRate) Reset() {
+	*x = TokenRate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[20]
+		mi := &file_batch_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Right) String() string {
+func (x *TokenRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Right) ProtoMessage() {}
+func (*TokenRate) ProtoMessage() {}
 
-func (x *Right) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[20]
+func (x *TokenRate) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,79 +1585,99 @@ func (x *Right) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Right.ProtoReflect.Descriptor instead.
-func (*Right) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TokenRate.ProtoReflect.Descriptor instead.
+func (*TokenRate) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Right) GetChannelName() string {
+func (x *TokenRate) GetDealType() string {
 	if x != nil {
-		return x.ChannelName
+		return x.DealType
 	}
 	return ""
 }
 
-func (x *Right) GetChaincodeName() string {
+func (x *TokenRate) GetCurrency() string {
 	if x != nil {
-		return x.ChaincodeName
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *Right) GetRoleName() string {
+func (x *TokenRate) GetRate() []byte {
 	if x != nil {
-		return x.RoleName
+		return x.Rate
 	}
-	return ""
+	return nil
 }
 
-func (x *Right) GetOperationName() string {
+func (x *TokenRate) GetMin() []byte {
 	if x != nil {
-		return x.OperationName
+		return x.Min
 	}
-	return ""
+	return nil
 }
 
-func (x *Right) GetAddress() *Address {
+func (x *TokenRate) GetMax() []byte {
 	if x != nil {
-		return x.Address
+		return x.Max
 	}
 	return nil
 }
 
-func (x *Right) GetHaveRight() *HaveRight {
+func (x *TokenRate) GetIssuer() *Address {
 	if x != nil {
-		return x.HaveRight
+		return x.Issuer
 	}
 	return nil
 }
 
-type AccountRights struct {
+func (x *TokenRate) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *TokenRate) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+type Token struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Rights  []*Right `protobuf:"bytes,2,rep,name=rights,proto3" json:"rights,omitempty"`
+	TotalEmission []byte          `protobuf:"bytes,1,opt,name=total_emission,json=totalEmission,proto3" json:"total_emission,omitempty"`
+	Fee           *TokenFee       `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Rates         []*TokenRate    `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"`
+	FeeAddress    []byte          `protobuf:"bytes,4,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	MaxSupply     []byte          `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"` // cap of the total emission, empty or zero - no cap
+	FeeSchedules  []*FeeSchedule  `protobuf:"bytes,6,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules,omitempty"`
+	FeeRecipients []*FeeRecipient `protobuf:"bytes,7,rep,name=fee_recipients,json=feeRecipients,proto3" json:"fee_recipients,omitempty"` // if set, fees are split between them instead of fee_address
+	Version       uint64          `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`                                 // version of the token migration the config is stored with
 }
 
-func (x *AccountRights) Reset() {
-	*x = AccountRights{}
+func (x *Token) Reset() {
+	*x = Token{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[21]
+		mi := &file_batch_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccountRights) String() string {
+func (x *Token) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountRights) ProtoMessage() {}
+func (*Token) ProtoMessage() {}
 
-func (x *AccountRights) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[21]
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,82 +1688,77 @@ func (x *AccountRights) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountRights.ProtoReflect.Descriptor instead.
-func (*AccountRights) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AccountRights) GetAddress() *Address {
+func (x *Token) GetTotalEmission() []byte {
 	if x != nil {
-		return x.Address
+		return x.TotalEmission
 	}
 	return nil
 }
 
-func (x *AccountRights) GetRights() []*Right {
+func (x *Token) GetFee() *TokenFee {
 	if x != nil {
-		return x.Rights
+		return x.Fee
 	}
 	return nil
 }
 
-type Accounts struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Addresses []*Address `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+func (x *Token) GetRates() []*TokenRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
 }
 
-func (x *Accounts) Reset() {
-	*x = Accounts{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Token) GetFeeAddress() []byte {
+	if x != nil {
+		return x.FeeAddress
 	}
+	return nil
 }
 
-func (x *Accounts) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Token) GetMaxSupply() []byte {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return nil
 }
 
-func (*Accounts) ProtoMessage() {}
-
-func (x *Accounts) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Token) GetFeeSchedules() []*FeeSchedule {
+	if x != nil {
+		return x.FeeSchedules
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Accounts.ProtoReflect.Descriptor instead.
-func (*Accounts) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{22}
+func (x *Token) GetFeeRecipients() []*FeeRecipient {
+	if x != nil {
+		return x.FeeRecipients
+	}
+	return nil
 }
 
-func (x *Accounts) GetAddresses() []*Address {
+func (x *Token) GetVersion() uint64 {
 	if x != nil {
-		return x.Addresses
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-type Operations struct {
+type HaveRight struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operations []string `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	HaveRight bool `protobuf:"varint,1,opt,name=haveRight,proto3" json:"haveRight,omitempty"`
 }
 
-func (x *Operations) Reset() {
-	*x = Operations{}
+func (x *HaveRight) Reset() {
+	*x = HaveRight{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_batch_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1622,13 +1766,13 @@ func (x *Operations) Reset() {
 	}
 }
 
-func (x *Operations) String() string {
+func (x *HaveRight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operations) ProtoMessage() {}
+func (*HaveRight) ProtoMessage() {}
 
-func (x *Operations) ProtoReflect() protoreflect.Message {
+func (x *HaveRight) ProtoReflect() protoreflect.Message {
 	mi := &file_batch_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1640,29 +1784,33 @@ func (x *Operations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operations.ProtoReflect.Descriptor instead.
-func (*Operations) Descriptor() ([]byte, []int) {
+// Deprecated: Use HaveRight.ProtoReflect.Descriptor instead.
+func (*HaveRight) Descriptor() ([]byte, []int) {
 	return file_batch_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Operations) GetOperations() []string {
+func (x *HaveRight) GetHaveRight() bool {
 	if x != nil {
-		return x.Operations
+		return x.HaveRight
 	}
-	return nil
+	return false
 }
 
-type OperationRights struct {
+type Right struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OperationName string   `protobuf:"bytes,1,opt,name=operationName,proto3" json:"operationName,omitempty"`
-	Rights        []*Right `protobuf:"bytes,2,rep,name=rights,proto3" json:"rights,omitempty"`
+	ChannelName   string     `protobuf:"bytes,1,opt,name=channelName,proto3" json:"channelName,omitempty"`
+	ChaincodeName string     `protobuf:"bytes,2,opt,name=chaincodeName,proto3" json:"chaincodeName,omitempty"`
+	RoleName      string     `protobuf:"bytes,3,opt,name=roleName,proto3" json:"roleName,omitempty"`
+	OperationName string     `protobuf:"bytes,4,opt,name=operationName,proto3" json:"operationName,omitempty"`
+	Address       *Address   `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	HaveRight     *HaveRight `protobuf:"bytes,6,opt,name=haveRight,proto3" json:"haveRight,omitempty"`
 }
 
-func (x *OperationRights) Reset() {
-	*x = OperationRights{}
+func (x *Right) Reset() {
+	*x = Right{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_batch_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1670,13 +1818,13 @@ func (x *OperationRights) Reset() {
 	}
 }
 
-func (x *OperationRights) String() string {
+func (x *Right) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationRights) ProtoMessage() {}
+func (*Right) ProtoMessage() {}
 
-func (x *OperationRights) ProtoReflect() protoreflect.Message {
+func (x *Right) ProtoReflect() protoreflect.Message {
 	mi := &file_batch_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1688,39 +1836,64 @@ func (x *OperationRights) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationRights.ProtoReflect.Descriptor instead.
-func (*OperationRights) Descriptor() ([]byte, []int) {
+// Deprecated: Use Right.ProtoReflect.Descriptor instead.
+func (*Right) Descriptor() ([]byte, []int) {
 	return file_batch_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *OperationRights) GetOperationName() string {
+func (x *Right) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+func (x *Right) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+func (x *Right) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *Right) GetOperationName() string {
 	if x != nil {
 		return x.OperationName
 	}
 	return ""
 }
 
-func (x *OperationRights) GetRights() []*Right {
+func (x *Right) GetAddress() *Address {
 	if x != nil {
-		return x.Rights
+		return x.Address
 	}
 	return nil
 }
 
-type Industrial struct {
+func (x *Right) GetHaveRight() *HaveRight {
+	if x != nil {
+		return x.HaveRight
+	}
+	return nil
+}
+
+type AccountRights struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groups      []*IndustrialGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	Fee         *TokenFee          `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
-	Rates       []*TokenRate       `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"`
-	FeeAddress  []byte             `protobuf:"bytes,4,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
-	Initialized bool               `protobuf:"varint,5,opt,name=initialized,proto3" json:"initialized,omitempty"`
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rights  []*Right `protobuf:"bytes,2,rep,name=rights,proto3" json:"rights,omitempty"`
 }
 
-func (x *Industrial) Reset() {
-	*x = Industrial{}
+func (x *AccountRights) Reset() {
+	*x = AccountRights{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_batch_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1728,13 +1901,13 @@ func (x *Industrial) Reset() {
 	}
 }
 
-func (x *Industrial) String() string {
+func (x *AccountRights) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Industrial) ProtoMessage() {}
+func (*AccountRights) ProtoMessage() {}
 
-func (x *Industrial) ProtoReflect() protoreflect.Message {
+func (x *AccountRights) ProtoReflect() protoreflect.Message {
 	mi := &file_batch_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1746,31 +1919,238 @@ func (x *Industrial) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Industrial.ProtoReflect.Descriptor instead.
-func (*Industrial) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountRights.ProtoReflect.Descriptor instead.
+func (*AccountRights) Descriptor() ([]byte, []int) {
 	return file_batch_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Industrial) GetGroups() []*IndustrialGroup {
+func (x *AccountRights) GetAddress() *Address {
 	if x != nil {
-		return x.Groups
+		return x.Address
 	}
 	return nil
 }
 
-func (x *Industrial) GetFee() *TokenFee {
+func (x *AccountRights) GetRights() []*Right {
 	if x != nil {
-		return x.Fee
+		return x.Rights
 	}
 	return nil
 }
 
-func (x *Industrial) GetRates() []*TokenRate {
-	if x != nil {
-		return x.Rates
-	}
-	return nil
-}
+type Accounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []*Address `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *Accounts) Reset() {
+	*x = Accounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Accounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Accounts) ProtoMessage() {}
+
+func (x *Accounts) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Accounts.ProtoReflect.Descriptor instead.
+func (*Accounts) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Accounts) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type Operations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []string `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *Operations) Reset() {
+	*x = Operations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operations) ProtoMessage() {}
+
+func (x *Operations) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operations.ProtoReflect.Descriptor instead.
+func (*Operations) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Operations) GetOperations() []string {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type OperationRights struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationName string   `protobuf:"bytes,1,opt,name=operationName,proto3" json:"operationName,omitempty"`
+	Rights        []*Right `protobuf:"bytes,2,rep,name=rights,proto3" json:"rights,omitempty"`
+}
+
+func (x *OperationRights) Reset() {
+	*x = OperationRights{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationRights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationRights) ProtoMessage() {}
+
+func (x *OperationRights) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationRights.ProtoReflect.Descriptor instead.
+func (*OperationRights) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OperationRights) GetOperationName() string {
+	if x != nil {
+		return x.OperationName
+	}
+	return ""
+}
+
+func (x *OperationRights) GetRights() []*Right {
+	if x != nil {
+		return x.Rights
+	}
+	return nil
+}
+
+type Industrial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups      []*IndustrialGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Fee         *TokenFee          `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Rates       []*TokenRate       `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"`
+	FeeAddress  []byte             `protobuf:"bytes,4,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	Initialized bool               `protobuf:"varint,5,opt,name=initialized,proto3" json:"initialized,omitempty"`
+}
+
+func (x *Industrial) Reset() {
+	*x = Industrial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Industrial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Industrial) ProtoMessage() {}
+
+func (x *Industrial) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Industrial.ProtoReflect.Descriptor instead.
+func (*Industrial) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Industrial) GetGroups() []*IndustrialGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Industrial) GetFee() *TokenFee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *Industrial) GetRates() []*TokenRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
 
 func (x *Industrial) GetFeeAddress() []byte {
 	if x != nil {
@@ -1800,7 +2180,7 @@ type IndustrialGroup struct {
 func (x *IndustrialGroup) Reset() {
 	*x = IndustrialGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[26]
+		mi := &file_batch_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1813,7 +2193,7 @@ func (x *IndustrialGroup) String() string {
 func (*IndustrialGroup) ProtoMessage() {}
 
 func (x *IndustrialGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[26]
+	mi := &file_batch_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,7 +2206,7 @@ func (x *IndustrialGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndustrialGroup.ProtoReflect.Descriptor instead.
 func (*IndustrialGroup) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{26}
+	return file_batch_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *IndustrialGroup) GetId() string {
@@ -1870,7 +2250,7 @@ type AccountInfo struct {
 func (x *AccountInfo) Reset() {
 	*x = AccountInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[27]
+		mi := &file_batch_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1883,7 +2263,7 @@ func (x *AccountInfo) String() string {
 func (*AccountInfo) ProtoMessage() {}
 
 func (x *AccountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[27]
+	mi := &file_batch_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1896,7 +2276,7 @@ func (x *AccountInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
 func (*AccountInfo) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{27}
+	return file_batch_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AccountInfo) GetKycHash() string {
@@ -1934,7 +2314,7 @@ type Address struct {
 func (x *Address) Reset() {
 	*x = Address{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[28]
+		mi := &file_batch_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1947,7 +2327,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[28]
+	mi := &file_batch_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1960,7 +2340,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{28}
+	return file_batch_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Address) GetUserID() string {
@@ -2006,7 +2386,7 @@ type SignedAddress struct {
 func (x *SignedAddress) Reset() {
 	*x = SignedAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[29]
+		mi := &file_batch_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2019,7 +2399,7 @@ func (x *SignedAddress) String() string {
 func (*SignedAddress) ProtoMessage() {}
 
 func (x *SignedAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[29]
+	mi := &file_batch_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2032,7 +2412,7 @@ func (x *SignedAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignedAddress.ProtoReflect.Descriptor instead.
 func (*SignedAddress) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{29}
+	return file_batch_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SignedAddress) GetAddress() *Address {
@@ -2078,12 +2458,17 @@ type SignaturePolicy struct {
 	N                   uint32   `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
 	PubKeys             [][]byte `protobuf:"bytes,3,rep,name=pubKeys,proto3" json:"pubKeys,omitempty"`
 	ReplaceKeysSignedTx []string `protobuf:"bytes,4,rep,name=replaceKeysSignedTx,proto3" json:"replaceKeysSignedTx,omitempty"`
+	// weighted policy: sum of weights of valid signatures must reach threshold,
+	// keys absent in weightedKeys have weight 1. If threshold is 0, n is used
+	WeightedKeys    []*WeightedKey `protobuf:"bytes,5,rep,name=weightedKeys,proto3" json:"weightedKeys,omitempty"`
+	Threshold       uint32         `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	RequiredPubKeys [][]byte       `protobuf:"bytes,7,rep,name=requiredPubKeys,proto3" json:"requiredPubKeys,omitempty"` // keys which must always sign
 }
 
 func (x *SignaturePolicy) Reset() {
 	*x = SignaturePolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[30]
+		mi := &file_batch_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2096,7 +2481,7 @@ func (x *SignaturePolicy) String() string {
 func (*SignaturePolicy) ProtoMessage() {}
 
 func (x *SignaturePolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[30]
+	mi := &file_batch_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,7 +2494,7 @@ func (x *SignaturePolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignaturePolicy.ProtoReflect.Descriptor instead.
 func (*SignaturePolicy) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{30}
+	return file_batch_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SignaturePolicy) GetN() uint32 {
@@ -2133,19 +2518,40 @@ func (x *SignaturePolicy) GetReplaceKeysSignedTx() []string {
 	return nil
 }
 
-type AclResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SignaturePolicy) GetWeightedKeys() []*WeightedKey {
+	if x != nil {
+		return x.WeightedKeys
+	}
+	return nil
+}
 
-	Account *AccountInfo   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Address *SignedAddress `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+func (x *SignaturePolicy) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SignaturePolicy) GetRequiredPubKeys() [][]byte {
+	if x != nil {
+		return x.RequiredPubKeys
+	}
+	return nil
+}
+
+type AclResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AccountInfo   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Address *SignedAddress `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
 func (x *AclResponse) Reset() {
 	*x = AclResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[31]
+		mi := &file_batch_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2158,7 +2564,7 @@ func (x *AclResponse) String() string {
 func (*AclResponse) ProtoMessage() {}
 
 func (x *AclResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[31]
+	mi := &file_batch_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2171,7 +2577,7 @@ func (x *AclResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AclResponse.ProtoReflect.Descriptor instead.
 func (*AclResponse) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{31}
+	return file_batch_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *AclResponse) GetAccount() *AccountInfo {
@@ -2199,7 +2605,7 @@ type Nonce struct {
 func (x *Nonce) Reset() {
 	*x = Nonce{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[32]
+		mi := &file_batch_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2212,7 +2618,7 @@ func (x *Nonce) String() string {
 func (*Nonce) ProtoMessage() {}
 
 func (x *Nonce) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[32]
+	mi := &file_batch_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,7 +2631,7 @@ func (x *Nonce) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Nonce.ProtoReflect.Descriptor instead.
 func (*Nonce) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{32}
+	return file_batch_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *Nonce) GetNonce() []uint64 {
@@ -2251,7 +2657,7 @@ type PendingTx struct {
 func (x *PendingTx) Reset() {
 	*x = PendingTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[33]
+		mi := &file_batch_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2264,7 +2670,7 @@ func (x *PendingTx) String() string {
 func (*PendingTx) ProtoMessage() {}
 
 func (x *PendingTx) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[33]
+	mi := &file_batch_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,86 +2683,488 @@ func (x *PendingTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingTx.ProtoReflect.Descriptor instead.
 func (*PendingTx) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{33}
+	return file_batch_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PendingTx) GetMethod() string {
 	if x != nil {
 		return x.Method
 	}
-	return ""
+	return ""
+}
+
+func (x *PendingTx) GetSender() *Address {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *PendingTx) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *PendingTx) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PendingTx) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type CCTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // unique transfer id
+	From   string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`     // channel from
+	To     string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`         // channel to
+	Token  string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`   // transfer token
+	User   []byte `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`     // token holder
+	Amount []byte `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"` // number of tokens
+	// Transfer direction is an additional variable made for convenience
+	// to avoid calculating it every time. It is calculated once when filling the structure
+	// when executing a transaction.
+	// Depending on the direction, different balances change.
+	// Examples:
+	// Direct transfer: from channel A to channel B we transfer tokens A
+	// or from channel B to channel A transfer tokens B
+	// Reverse transfer: from channel A to channel B transfer tokens B
+	// or from channel B to channel A transfer tokens A
+	ForwardDirection bool  `protobuf:"varint,7,opt,name=forward_direction,json=forwardDirection,proto3" json:"forward_direction,omitempty"`
+	IsCommit         bool  `protobuf:"varint,8,opt,name=isCommit,proto3" json:"isCommit,omitempty"`                            // phase 2 sign
+	TimeAsNanos      int64 `protobuf:"varint,9,opt,name=time_as_nanos,json=timeAsNanos,proto3" json:"time_as_nanos,omitempty"` // transfer creation time in nanoseconds
+}
+
+func (x *CCTransfer) Reset() {
+	*x = CCTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CCTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CCTransfer) ProtoMessage() {}
+
+func (x *CCTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CCTransfer.ProtoReflect.Descriptor instead.
+func (*CCTransfer) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CCTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CCTransfer) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CCTransfer) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CCTransfer) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CCTransfer) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *CCTransfer) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CCTransfer) GetForwardDirection() bool {
+	if x != nil {
+		return x.ForwardDirection
+	}
+	return false
+}
+
+func (x *CCTransfer) GetIsCommit() bool {
+	if x != nil {
+		return x.IsCommit
+	}
+	return false
+}
+
+func (x *CCTransfer) GetTimeAsNanos() int64 {
+	if x != nil {
+		return x.TimeAsNanos
+	}
+	return 0
+}
+
+type CCTransfers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bookmark string        `protobuf:"bytes,1,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+	Ccts     []*CCTransfer `protobuf:"bytes,2,rep,name=ccts,proto3" json:"ccts,omitempty"`
+}
+
+func (x *CCTransfers) Reset() {
+	*x = CCTransfers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CCTransfers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CCTransfers) ProtoMessage() {}
+
+func (x *CCTransfers) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CCTransfers.ProtoReflect.Descriptor instead.
+func (*CCTransfers) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CCTransfers) GetBookmark() string {
+	if x != nil {
+		return x.Bookmark
+	}
+	return ""
+}
+
+func (x *CCTransfers) GetCcts() []*CCTransfer {
+	if x != nil {
+		return x.Ccts
+	}
+	return nil
+}
+
+type WeightedKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKey []byte `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *WeightedKey) Reset() {
+	*x = WeightedKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeightedKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedKey) ProtoMessage() {}
+
+func (x *WeightedKey) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedKey.ProtoReflect.Descriptor instead.
+func (*WeightedKey) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WeightedKey) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *WeightedKey) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// BalanceChange describes a single balance mutation: the amount moves from the "from" balance
+// of one kind to the "to" balance of another kind. Empty from means emission, empty to means burning
+type BalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string             `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	FromKind    BalanceChange_Kind `protobuf:"varint,2,opt,name=fromKind,proto3,enum=proto.BalanceChange_Kind" json:"fromKind,omitempty"`
+	ToKind      BalanceChange_Kind `protobuf:"varint,3,opt,name=toKind,proto3,enum=proto.BalanceChange_Kind" json:"toKind,omitempty"`
+	From        []byte             `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To          []byte             `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Amount      []byte             `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason      string             `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	FromBalance []byte             `protobuf:"bytes,8,opt,name=fromBalance,proto3" json:"fromBalance,omitempty"` // resulting balance of the "from" address
+	ToBalance   []byte             `protobuf:"bytes,9,opt,name=toBalance,proto3" json:"toBalance,omitempty"`     // resulting balance of the "to" address
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BalanceChange) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetFromKind() BalanceChange_Kind {
+	if x != nil {
+		return x.FromKind
+	}
+	return BalanceChange_TOKEN
+}
+
+func (x *BalanceChange) GetToKind() BalanceChange_Kind {
+	if x != nil {
+		return x.ToKind
+	}
+	return BalanceChange_TOKEN
+}
+
+func (x *BalanceChange) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetFromBalance() []byte {
+	if x != nil {
+		return x.FromBalance
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetToBalance() []byte {
+	if x != nil {
+		return x.ToBalance
+	}
+	return nil
+}
+
+type BalanceChanges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*BalanceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// the event set by the method, Fabric keeps only one event per transaction
+	// so the BalanceChanges event carries it
+	EventName    string `protobuf:"bytes,2,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	EventPayload []byte `protobuf:"bytes,3,opt,name=eventPayload,proto3" json:"eventPayload,omitempty"`
+}
+
+func (x *BalanceChanges) Reset() {
+	*x = BalanceChanges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_batch_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChanges) ProtoMessage() {}
+
+func (x *BalanceChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *PendingTx) GetSender() *Address {
-	if x != nil {
-		return x.Sender
-	}
-	return nil
+// Deprecated: Use BalanceChanges.ProtoReflect.Descriptor instead.
+func (*BalanceChanges) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PendingTx) GetArgs() []string {
+func (x *BalanceChanges) GetChanges() []*BalanceChange {
 	if x != nil {
-		return x.Args
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *PendingTx) GetTimestamp() int64 {
+func (x *BalanceChanges) GetEventName() string {
 	if x != nil {
-		return x.Timestamp
+		return x.EventName
 	}
-	return 0
+	return ""
 }
 
-func (x *PendingTx) GetNonce() uint64 {
+func (x *BalanceChanges) GetEventPayload() []byte {
 	if x != nil {
-		return x.Nonce
+		return x.EventPayload
 	}
-	return 0
+	return nil
 }
 
-type CCTransfer struct {
+// JournalEntry is one side of a balance change in the per-address journal
+type JournalEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // unique transfer id
-	From   string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`     // channel from
-	To     string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`         // channel to
-	Token  string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`   // token to transfer
-	User   []byte `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`     // owner of tokens
-	Amount []byte `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"` // amount of tokens
-	// Transfer direction is an additional variable made for convenience
-	// to avoid calculating it every time. It is calculated once when filling the structure
-	// when executing a transaction.
-	// Depending on the direction, different balances change.
-	// Examples:
-	// Direct transfer: from channel A to channel B we transfer tokens A
-	// or from channel B to channel A transfer tokens B
-	// Reverse transfer: from channel A to channel B transfer tokens B
-	// or from channel B to channel A transfer tokens A
-	ForwardDirection bool  `protobuf:"varint,7,opt,name=forward_direction,json=forwardDirection,proto3" json:"forward_direction,omitempty"`
-	IsCommit         bool  `protobuf:"varint,8,opt,name=isCommit,proto3" json:"isCommit,omitempty"`                            // phase 2 sign
-	TimeAsNanos      int64 `protobuf:"varint,9,opt,name=time_as_nanos,json=timeAsNanos,proto3" json:"time_as_nanos,omitempty"` // transfer creation time in nanoseconds
-}
-
-func (x *CCTransfer) Reset() {
-	*x = CCTransfer{}
+	Seq              uint64             `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"` // sequence number of the entry of the address
+	TxID             string             `protobuf:"bytes,2,opt,name=txID,proto3" json:"txID,omitempty"`
+	Timestamp        int64              `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // transaction timestamp in seconds
+	Token            string             `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Kind             BalanceChange_Kind `protobuf:"varint,5,opt,name=kind,proto3,enum=proto.BalanceChange_Kind" json:"kind,omitempty"`
+	Credit           bool               `protobuf:"varint,6,opt,name=credit,proto3" json:"credit,omitempty"`            // true if the amount is put to the balance, false if it's taken from it
+	Counterparty     []byte             `protobuf:"bytes,7,opt,name=counterparty,proto3" json:"counterparty,omitempty"` // empty for emission and burning
+	CounterpartyKind BalanceChange_Kind `protobuf:"varint,8,opt,name=counterpartyKind,proto3,enum=proto.BalanceChange_Kind" json:"counterpartyKind,omitempty"`
+	Amount           []byte             `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance          []byte             `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance,omitempty"` // resulting balance
+	Reason           string             `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[34]
+		mi := &file_batch_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CCTransfer) String() string {
+func (x *JournalEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CCTransfer) ProtoMessage() {}
+func (*JournalEntry) ProtoMessage() {}
 
-func (x *CCTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[34]
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2367,100 +3175,114 @@ func (x *CCTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CCTransfer.ProtoReflect.Descriptor instead.
-func (*CCTransfer) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CCTransfer) GetId() string {
+func (x *JournalEntry) GetSeq() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Seq
 	}
-	return ""
+	return 0
 }
 
-func (x *CCTransfer) GetFrom() string {
+func (x *JournalEntry) GetTxID() string {
 	if x != nil {
-		return x.From
+		return x.TxID
 	}
 	return ""
 }
 
-func (x *CCTransfer) GetTo() string {
+func (x *JournalEntry) GetTimestamp() int64 {
 	if x != nil {
-		return x.To
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *CCTransfer) GetToken() string {
+func (x *JournalEntry) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *CCTransfer) GetUser() []byte {
+func (x *JournalEntry) GetKind() BalanceChange_Kind {
 	if x != nil {
-		return x.User
+		return x.Kind
 	}
-	return nil
+	return BalanceChange_TOKEN
 }
 
-func (x *CCTransfer) GetAmount() []byte {
+func (x *JournalEntry) GetCredit() bool {
 	if x != nil {
-		return x.Amount
+		return x.Credit
+	}
+	return false
+}
+
+func (x *JournalEntry) GetCounterparty() []byte {
+	if x != nil {
+		return x.Counterparty
 	}
 	return nil
 }
 
-func (x *CCTransfer) GetForwardDirection() bool {
+func (x *JournalEntry) GetCounterpartyKind() BalanceChange_Kind {
 	if x != nil {
-		return x.ForwardDirection
+		return x.CounterpartyKind
 	}
-	return false
+	return BalanceChange_TOKEN
 }
 
-func (x *CCTransfer) GetIsCommit() bool {
+func (x *JournalEntry) GetAmount() []byte {
 	if x != nil {
-		return x.IsCommit
+		return x.Amount
 	}
-	return false
+	return nil
 }
 
-func (x *CCTransfer) GetTimeAsNanos() int64 {
+func (x *JournalEntry) GetBalance() []byte {
 	if x != nil {
-		return x.TimeAsNanos
+		return x.Balance
 	}
-	return 0
+	return nil
 }
 
-type CCTransfers struct {
+func (x *JournalEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type JournalEntries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bookmark string        `protobuf:"bytes,1,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
-	Ccts     []*CCTransfer `protobuf:"bytes,2,rep,name=ccts,proto3" json:"ccts,omitempty"`
+	Bookmark string          `protobuf:"bytes,1,opt,name=bookmark,proto3" json:"bookmark,omitempty"`
+	Entries  []*JournalEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *CCTransfers) Reset() {
-	*x = CCTransfers{}
+func (x *JournalEntries) Reset() {
+	*x = JournalEntries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_batch_proto_msgTypes[35]
+		mi := &file_batch_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CCTransfers) String() string {
+func (x *JournalEntries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CCTransfers) ProtoMessage() {}
+func (*JournalEntries) ProtoMessage() {}
 
-func (x *CCTransfers) ProtoReflect() protoreflect.Message {
-	mi := &file_batch_proto_msgTypes[35]
+func (x *JournalEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_batch_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,21 +3293,21 @@ func (x *CCTransfers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CCTransfers.ProtoReflect.Descriptor instead.
-func (*CCTransfers) Descriptor() ([]byte, []int) {
-	return file_batch_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use JournalEntries.ProtoReflect.Descriptor instead.
+func (*JournalEntries) Descriptor() ([]byte, []int) {
+	return file_batch_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CCTransfers) GetBookmark() string {
+func (x *JournalEntries) GetBookmark() string {
 	if x != nil {
 		return x.Bookmark
 	}
 	return ""
 }
 
-func (x *CCTransfers) GetCcts() []*CCTransfer {
+func (x *JournalEntries) GetEntries() []*JournalEntry {
 	if x != nil {
-		return x.Ccts
+		return x.Entries
 	}
 	return nil
 }
@@ -2540,95 +3362,122 @@ var file_batch_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x05, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
 	0x69, 0x53, 0x77, 0x61, 0x70, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x77, 0x61, 0x70,
-	0x73, 0x22, 0x5a, 0x0a, 0x08, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x61, 0x74, 0x6f, 0x6d, 0x79, 0x7a, 0x65, 0x53, 0x4b, 0x49, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x61, 0x74, 0x6f, 0x6d, 0x79, 0x7a, 0x65, 0x53, 0x4b, 0x49, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x4b, 0x49, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x4b, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x55, 0x0a,
-	0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x77, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x06, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x31, 0x0a, 0x05, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8d, 0x01, 0x0a,
-	0x0a, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x73, 0x22, 0xa4, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x69, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x74, 0x6f, 0x6d, 0x79, 0x7a, 0x65, 0x53, 0x4b, 0x49, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x61, 0x74, 0x6f, 0x6d, 0x79, 0x7a, 0x65, 0x53, 0x4b, 0x49, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x4b, 0x49, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x72, 0x6f, 0x62, 0x6f, 0x74, 0x53, 0x4b, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x55, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x9d, 0x01, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x2b, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0xd9, 0x01, 0x0a,
-	0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0a, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x39, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0xb6, 0x02, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b,
-	0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0d, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52,
-	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x3a, 0x0a,
-	0x0e, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x77,
-	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x12, 0x73, 0x77, 0x61,
-	0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x77,
-	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x10, 0x73, 0x77, 0x61, 0x70,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x12,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73, 0x77,
-	0x61, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x77, 0x61, 0x70, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x77, 0x61, 0x70, 0x22, 0x1c, 0x0a, 0x06,
-	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x60, 0x0a, 0x08, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x03, 0x66, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x61, 0x70, 0x22, 0xa4, 0x01, 0x0a,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x31, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x54, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2a, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x06, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x22, 0x39, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb6, 0x02,
+	0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x12, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x10, 0x73, 0x77, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x53, 0x77, 0x61, 0x70, 0x22, 0x1c, 0x0a, 0x06, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x22, 0x60, 0x0a, 0x08, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x65, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x63, 0x61, 0x70, 0x22, 0x55, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x61, 0x70, 0x22, 0x6d, 0x0a,
+	0x0b, 0x46, 0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65,
+	0x65, 0x54, 0x69, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x0c,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0xe4, 0x01, 0x0a,
 	0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65,
 	0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
 	0x65, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
@@ -2639,7 +3488,11 @@ var file_batch_proto_rawDesc = []byte{
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x26, 0x0a, 0x06, 0x69,
 	0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a,
+	0x75, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x22, 0xc8, 0x02, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a,
 	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6d, 0x69, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -2649,136 +3502,222 @@ var file_batch_proto_rawDesc = []byte{
 	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12,
 	0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x29, 0x0a, 0x09, 0x48, 0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x68, 0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x68, 0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x05,
-	0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x28, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x68, 0x61, 0x76,
-	0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x52, 0x09,
-	0x68, 0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5f, 0x0a, 0x0d, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x69, 0x67,
-	0x68, 0x74, 0x52, 0x06, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x08, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x5d, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x72,
-	0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x72, 0x69, 0x67, 0x68, 0x74,
-	0x73, 0x22, 0xca, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x61, 0x6c,
-	0x12, 0x2e, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72,
-	0x69, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x12, 0x21, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x03,
-	0x66, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x65, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x66, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x6d,
-	0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x69, 0x0a,
-	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
-	0x6b, 0x79, 0x63, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
-	0x79, 0x63, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x62, 0x6c, 0x61,
-	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73,
-	0x74, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x49,
-	0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54,
-	0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54,
-	0x78, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65,
-	0x79, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79,
-	0x73, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x13, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x54, 0x78, 0x22, 0x6b, 0x0a, 0x0b, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x1d, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x22, 0x93, 0x01, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x0a, 0x43, 0x43, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x73, 0x5f,
-	0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d,
-	0x65, 0x41, 0x73, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x50, 0x0a, 0x0b, 0x43, 0x43, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d,
-	0x61, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d,
-	0x61, 0x72, 0x6b, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x43, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x52, 0x04, 0x63, 0x63, 0x74, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f,
-	0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x37, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x65, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29,
+	0x0a, 0x09, 0x48, 0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x68,
+	0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x68, 0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x05, 0x52, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x68, 0x61, 0x76, 0x65, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x48, 0x61, 0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x52, 0x09, 0x68, 0x61,
+	0x76, 0x65, 0x52, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5f, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x06, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x5d, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
+	0xca, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x2e,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x61,
+	0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21,
+	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x03, 0x66, 0x65,
+	0x65, 0x12, 0x26, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x66, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x0f,
+	0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x69, 0x0a, 0x0b, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x79,
+	0x63, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x79, 0x63,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x62, 0x6c, 0x61, 0x63, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72,
+	0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x49, 0x6e, 0x64,
+	0x75, 0x73, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x12,
+	0x40, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x73,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x78, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x54, 0x78, 0x12, 0x36, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x0c, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x73, 0x22, 0x6b, 0x0a, 0x0b, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x1d, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
+	0x93, 0x01, 0x0a, 0x09, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x0a, 0x43, 0x43, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x73, 0x5f, 0x6e,
+	0x61, 0x6e, 0x6f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x41, 0x73, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x50, 0x0a, 0x0b, 0x43, 0x43, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61,
+	0x72, 0x6b, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x04, 0x63, 0x63, 0x74, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x9b, 0x03, 0x0a, 0x0d, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x35, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x08, 0x66,
+	0x72, 0x6f, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x6f, 0x4b, 0x69, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x06, 0x74, 0x6f, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x76,
+	0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x02,
+	0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x44, 0x55, 0x53, 0x54, 0x52, 0x49,
+	0x41, 0x4c, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x5f, 0x49,
+	0x4e, 0x44, 0x55, 0x53, 0x54, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x47,
+	0x49, 0x56, 0x45, 0x4e, 0x10, 0x06, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xe4, 0x02, 0x0a, 0x0c,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x78, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x0e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42,
+	0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2793,87 +3732,110 @@ func file_batch_proto_rawDescGZIP() []byte {
 	return file_batch_proto_rawDescData
 }
 
-var file_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_batch_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_batch_proto_goTypes = []interface{}{
-	(*MultiSwap)(nil),        // 0: proto.MultiSwap
-	(*Asset)(nil),            // 1: proto.Asset
-	(*Swap)(nil),             // 2: proto.Swap
-	(*SwapKey)(nil),          // 3: proto.SwapKey
-	(*Batch)(nil),            // 4: proto.Batch
-	(*InitArgs)(nil),         // 5: proto.InitArgs
-	(*WriteElement)(nil),     // 6: proto.WriteElement
-	(*ResponseError)(nil),    // 7: proto.ResponseError
-	(*SwapResponse)(nil),     // 8: proto.SwapResponse
-	(*AccountingRecord)(nil), // 9: proto.AccountingRecord
-	(*Event)(nil),            // 10: proto.Event
-	(*TxResponse)(nil),       // 11: proto.TxResponse
-	(*BatchTxEvent)(nil),     // 12: proto.BatchTxEvent
-	(*BatchEvent)(nil),       // 13: proto.BatchEvent
-	(*BatchResponse)(nil),    // 14: proto.BatchResponse
-	(*Nested)(nil),           // 15: proto.Nested
-	(*TokenFee)(nil),         // 16: proto.TokenFee
-	(*TokenRate)(nil),        // 17: proto.TokenRate
-	(*Token)(nil),            // 18: proto.Token
-	(*HaveRight)(nil),        // 19: proto.HaveRight
-	(*Right)(nil),            // 20: proto.Right
-	(*AccountRights)(nil),    // 21: proto.AccountRights
-	(*Accounts)(nil),         // 22: proto.Accounts
-	(*Operations)(nil),       // 23: proto.Operations
-	(*OperationRights)(nil),  // 24: proto.OperationRights
-	(*Industrial)(nil),       // 25: proto.Industrial
-	(*IndustrialGroup)(nil),  // 26: proto.IndustrialGroup
-	(*AccountInfo)(nil),      // 27: proto.AccountInfo
-	(*Address)(nil),          // 28: proto.Address
-	(*SignedAddress)(nil),    // 29: proto.SignedAddress
-	(*SignaturePolicy)(nil),  // 30: proto.SignaturePolicy
-	(*AclResponse)(nil),      // 31: proto.AclResponse
-	(*Nonce)(nil),            // 32: proto.Nonce
-	(*PendingTx)(nil),        // 33: proto.pendingTx
-	(*CCTransfer)(nil),       // 34: proto.CCTransfer
-	(*CCTransfers)(nil),      // 35: proto.CCTransfers
+	(BalanceChange_Kind)(0),  // 0: proto.BalanceChange.Kind
+	(*MultiSwap)(nil),        // 1: proto.MultiSwap
+	(*Asset)(nil),            // 2: proto.Asset
+	(*Swap)(nil),             // 3: proto.Swap
+	(*SwapKey)(nil),          // 4: proto.SwapKey
+	(*Batch)(nil),            // 5: proto.Batch
+	(*InitArgs)(nil),         // 6: proto.InitArgs
+	(*InitOption)(nil),       // 7: proto.InitOption
+	(*WriteElement)(nil),     // 8: proto.WriteElement
+	(*ResponseError)(nil),    // 9: proto.ResponseError
+	(*SwapResponse)(nil),     // 10: proto.SwapResponse
+	(*AccountingRecord)(nil), // 11: proto.AccountingRecord
+	(*Event)(nil),            // 12: proto.Event
+	(*TxResponse)(nil),       // 13: proto.TxResponse
+	(*BatchTxEvent)(nil),     // 14: proto.BatchTxEvent
+	(*BatchEvent)(nil),       // 15: proto.BatchEvent
+	(*BatchResponse)(nil),    // 16: proto.BatchResponse
+	(*Nested)(nil),           // 17: proto.Nested
+	(*TokenFee)(nil),         // 18: proto.TokenFee
+	(*FeeTier)(nil),          // 19: proto.FeeTier
+	(*FeeSchedule)(nil),      // 20: proto.FeeSchedule
+	(*FeeRecipient)(nil),     // 21: proto.FeeRecipient
+	(*TokenRate)(nil),        // 22: proto.TokenRate
+	(*Token)(nil),            // 23: proto.Token
+	(*HaveRight)(nil),        // 24: proto.HaveRight
+	(*Right)(nil),            // 25: proto.Right
+	(*AccountRights)(nil),    // 26: proto.AccountRights
+	(*Accounts)(nil),         // 27: proto.Accounts
+	(*Operations)(nil),       // 28: proto.Operations
+	(*OperationRights)(nil),  // 29: proto.OperationRights
+	(*Industrial)(nil),       // 30: proto.Industrial
+	(*IndustrialGroup)(nil),  // 31: proto.IndustrialGroup
+	(*AccountInfo)(nil),      // 32: proto.AccountInfo
+	(*Address)(nil),          // 33: proto.Address
+	(*SignedAddress)(nil),    // 34: proto.SignedAddress
+	(*SignaturePolicy)(nil),  // 35: proto.SignaturePolicy
+	(*AclResponse)(nil),      // 36: proto.AclResponse
+	(*Nonce)(nil),            // 37: proto.Nonce
+	(*PendingTx)(nil),        // 38: proto.pendingTx
+	(*CCTransfer)(nil),       // 39: proto.CCTransfer
+	(*CCTransfers)(nil),      // 40: proto.CCTransfers
+	(*WeightedKey)(nil),      // 41: proto.WeightedKey
+	(*BalanceChange)(nil),    // 42: proto.BalanceChange
+	(*BalanceChanges)(nil),   // 43: proto.BalanceChanges
+	(*JournalEntry)(nil),     // 44: proto.JournalEntry
+	(*JournalEntries)(nil),   // 45: proto.JournalEntries
 }
 var file_batch_proto_depIdxs = []int32{
-	1,  // 0: proto.MultiSwap.assets:type_name -> proto.Asset
-	2,  // 1: proto.Batch.swaps:type_name -> proto.Swap
-	3,  // 2: proto.Batch.keys:type_name -> proto.SwapKey
-	3,  // 3: proto.Batch.multi_swaps_keys:type_name -> proto.SwapKey
-	0,  // 4: proto.Batch.multi_swaps:type_name -> proto.MultiSwap
-	7,  // 5: proto.SwapResponse.error:type_name -> proto.ResponseError
-	6,  // 6: proto.SwapResponse.writes:type_name -> proto.WriteElement
-	7,  // 7: proto.TxResponse.error:type_name -> proto.ResponseError
-	6,  // 8: proto.TxResponse.writes:type_name -> proto.WriteElement
-	7,  // 9: proto.BatchTxEvent.error:type_name -> proto.ResponseError
-	10, // 10: proto.BatchTxEvent.events:type_name -> proto.Event
-	9,  // 11: proto.BatchTxEvent.accounting:type_name -> proto.AccountingRecord
-	12, // 12: proto.BatchEvent.events:type_name -> proto.BatchTxEvent
-	11, // 13: proto.BatchResponse.tx_responses:type_name -> proto.TxResponse
-	2,  // 14: proto.BatchResponse.created_swaps:type_name -> proto.Swap
-	8,  // 15: proto.BatchResponse.swap_responses:type_name -> proto.SwapResponse
-	8,  // 16: proto.BatchResponse.swap_key_responses:type_name -> proto.SwapResponse
-	0,  // 17: proto.BatchResponse.created_multi_swap:type_name -> proto.MultiSwap
-	28, // 18: proto.TokenRate.issuer:type_name -> proto.Address
-	16, // 19: proto.Token.fee:type_name -> proto.TokenFee
-	17, // 20: proto.Token.rates:type_name -> proto.TokenRate
-	28, // 21: proto.Right.address:type_name -> proto.Address
-	19, // 22: proto.Right.haveRight:type_name -> proto.HaveRight
-	28, // 23: proto.AccountRights.address:type_name -> proto.Address
-	20, // 24: proto.AccountRights.rights:type_name -> proto.Right
-	28, // 25: proto.Accounts.addresses:type_name -> proto.Address
-	20, // 26: proto.OperationRights.rights:type_name -> proto.Right
-	26, // 27: proto.Industrial.groups:type_name -> proto.IndustrialGroup
-	16, // 28: proto.Industrial.fee:type_name -> proto.TokenFee
-	17, // 29: proto.Industrial.rates:type_name -> proto.TokenRate
-	28, // 30: proto.SignedAddress.address:type_name -> proto.Address
-	30, // 31: proto.SignedAddress.signaturePolicy:type_name -> proto.SignaturePolicy
-	27, // 32: proto.AclResponse.account:type_name -> proto.AccountInfo
-	29, // 33: proto.AclResponse.address:type_name -> proto.SignedAddress
-	28, // 34: proto.pendingTx.sender:type_name -> proto.Address
-	34, // 35: proto.CCTransfers.ccts:type_name -> proto.CCTransfer
-	36, // [36:36] is the sub-list for method output_type
-	36, // [36:36] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	2,  // 0: proto.MultiSwap.assets:type_name -> proto.Asset
+	3,  // 1: proto.Batch.swaps:type_name -> proto.Swap
+	4,  // 2: proto.Batch.keys:type_name -> proto.SwapKey
+	4,  // 3: proto.Batch.multi_swaps_keys:type_name -> proto.SwapKey
+	1,  // 4: proto.Batch.multi_swaps:type_name -> proto.MultiSwap
+	7,  // 5: proto.InitArgs.options:type_name -> proto.InitOption
+	9,  // 6: proto.SwapResponse.error:type_name -> proto.ResponseError
+	8,  // 7: proto.SwapResponse.writes:type_name -> proto.WriteElement
+	12, // 8: proto.SwapResponse.events:type_name -> proto.Event
+	9,  // 9: proto.TxResponse.error:type_name -> proto.ResponseError
+	8,  // 10: proto.TxResponse.writes:type_name -> proto.WriteElement
+	9,  // 11: proto.BatchTxEvent.error:type_name -> proto.ResponseError
+	12, // 12: proto.BatchTxEvent.events:type_name -> proto.Event
+	11, // 13: proto.BatchTxEvent.accounting:type_name -> proto.AccountingRecord
+	14, // 14: proto.BatchEvent.events:type_name -> proto.BatchTxEvent
+	13, // 15: proto.BatchResponse.tx_responses:type_name -> proto.TxResponse
+	3,  // 16: proto.BatchResponse.created_swaps:type_name -> proto.Swap
+	10, // 17: proto.BatchResponse.swap_responses:type_name -> proto.SwapResponse
+	10, // 18: proto.BatchResponse.swap_key_responses:type_name -> proto.SwapResponse
+	1,  // 19: proto.BatchResponse.created_multi_swap:type_name -> proto.MultiSwap
+	19, // 20: proto.FeeSchedule.tiers:type_name -> proto.FeeTier
+	33, // 21: proto.TokenRate.issuer:type_name -> proto.Address
+	18, // 22: proto.Token.fee:type_name -> proto.TokenFee
+	22, // 23: proto.Token.rates:type_name -> proto.TokenRate
+	20, // 24: proto.Token.fee_schedules:type_name -> proto.FeeSchedule
+	21, // 25: proto.Token.fee_recipients:type_name -> proto.FeeRecipient
+	33, // 26: proto.Right.address:type_name -> proto.Address
+	24, // 27: proto.Right.haveRight:type_name -> proto.HaveRight
+	33, // 28: proto.AccountRights.address:type_name -> proto.Address
+	25, // 29: proto.AccountRights.rights:type_name -> proto.Right
+	33, // 30: proto.Accounts.addresses:type_name -> proto.Address
+	25, // 31: proto.OperationRights.rights:type_name -> proto.Right
+	31, // 32: proto.Industrial.groups:type_name -> proto.IndustrialGroup
+	18, // 33: proto.Industrial.fee:type_name -> proto.TokenFee
+	22, // 34: proto.Industrial.rates:type_name -> proto.TokenRate
+	33, // 35: proto.SignedAddress.address:type_name -> proto.Address
+	35, // 36: proto.SignedAddress.signaturePolicy:type_name -> proto.SignaturePolicy
+	41, // 37: proto.SignaturePolicy.weightedKeys:type_name -> proto.WeightedKey
+	32, // 38: proto.AclResponse.account:type_name -> proto.AccountInfo
+	34, // 39: proto.AclResponse.address:type_name -> proto.SignedAddress
+	33, // 40: proto.pendingTx.sender:type_name -> proto.Address
+	39, // 41: proto.CCTransfers.ccts:type_name -> proto.CCTransfer
+	0,  // 42: proto.BalanceChange.fromKind:type_name -> proto.BalanceChange.Kind
+	0,  // 43: proto.BalanceChange.toKind:type_name -> proto.BalanceChange.Kind
+	42, // 44: proto.BalanceChanges.changes:type_name -> proto.BalanceChange
+	0,  // 45: proto.JournalEntry.kind:type_name -> proto.BalanceChange.Kind
+	0,  // 46: proto.JournalEntry.counterpartyKind:type_name -> proto.BalanceChange.Kind
+	44, // 47: proto.JournalEntries.entries:type_name -> proto.JournalEntry
+	48, // [48:48] is the sub-list for method output_type
+	48, // [48:48] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_batch_proto_init() }
@@ -2955,7 +3917,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteElement); i {
+			switch v := v.(*InitOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2967,7 +3929,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseError); i {
+			switch v := v.(*WriteElement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2979,7 +3941,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapResponse); i {
+			switch v := v.(*ResponseError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2991,7 +3953,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountingRecord); i {
+			switch v := v.(*SwapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3003,7 +3965,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+			switch v := v.(*AccountingRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3015,7 +3977,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxResponse); i {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3027,7 +3989,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchTxEvent); i {
+			switch v := v.(*TxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3039,7 +4001,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchEvent); i {
+			switch v := v.(*BatchTxEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3051,7 +4013,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchResponse); i {
+			switch v := v.(*BatchEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3063,7 +4025,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Nested); i {
+			switch v := v.(*BatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3075,7 +4037,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenFee); i {
+			switch v := v.(*Nested); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3087,7 +4049,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenRate); i {
+			switch v := v.(*TokenFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3099,7 +4061,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Token); i {
+			switch v := v.(*FeeTier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3111,7 +4073,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HaveRight); i {
+			switch v := v.(*FeeSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3123,7 +4085,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Right); i {
+			switch v := v.(*FeeRecipient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3135,7 +4097,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountRights); i {
+			switch v := v.(*TokenRate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3147,7 +4109,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Accounts); i {
+			switch v := v.(*Token); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3159,7 +4121,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operations); i {
+			switch v := v.(*HaveRight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3171,7 +4133,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationRights); i {
+			switch v := v.(*Right); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3183,7 +4145,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Industrial); i {
+			switch v := v.(*AccountRights); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3195,7 +4157,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndustrialGroup); i {
+			switch v := v.(*Accounts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3207,7 +4169,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountInfo); i {
+			switch v := v.(*Operations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3219,7 +4181,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
+			switch v := v.(*OperationRights); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3231,7 +4193,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedAddress); i {
+			switch v := v.(*Industrial); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3243,7 +4205,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignaturePolicy); i {
+			switch v := v.(*IndustrialGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3255,7 +4217,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AclResponse); i {
+			switch v := v.(*AccountInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3267,7 +4229,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Nonce); i {
+			switch v := v.(*Address); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3279,7 +4241,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingTx); i {
+			switch v := v.(*SignedAddress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3291,7 +4253,7 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CCTransfer); i {
+			switch v := v.(*SignaturePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3303,6 +4265,54 @@ func file_batch_proto_init() {
 			}
 		}
 		file_batch_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AclResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CCTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CCTransfers); i {
 			case 0:
 				return &v.state
@@ -3314,19 +4324,80 @@ func file_batch_proto_init() {
 				return nil
 			}
 		}
+		file_batch_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeightedKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChanges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JournalEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_batch_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JournalEntries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_batch_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   36,
+			NumEnums:      1,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_batch_proto_goTypes,
 		DependencyIndexes: file_batch_proto_depIdxs,
+		EnumInfos:         file_batch_proto_enumTypes,
 		MessageInfos:      file_batch_proto_msgTypes,
 	}.Build()
 	File_batch_proto = out.File
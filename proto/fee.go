@@ -0,0 +1,16 @@
+package proto
+
+import (
+	"encoding/json"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+// ConvertToCall - converts string into fee schedule, see foundation/core/reflect.go
+func (x *FeeSchedule) ConvertToCall(
+	_ shim.ChaincodeStubInterface,
+	in string,
+) (*FeeSchedule, error) {
+	err := json.Unmarshal([]byte(in), x)
+	return x, err
+}
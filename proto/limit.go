@@ -22,3 +22,8 @@ func (x *TokenRate) CalcPrice(amount *big.Int, rateDecimal uint64) *big.Int {
 		),
 	)
 }
+
+// IsValid checks if the rate is valid at the time in unix seconds
+func (x *TokenRate) IsValid(now int64) bool {
+	return (x.ValidFrom == 0 || now >= x.ValidFrom) && (x.ValidUntil == 0 || now < x.ValidUntil)
+}
@@ -0,0 +1,70 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/helpers"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCustomACLChaincode - Checking that ACL chaincode can be deployed with custom channel and chaincode names
+func TestCustomACLChaincode(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCodeWithOptions(testTokenCCName, tt, &core.ContractOptions{},
+		[]core.ChaincodeOption{core.WithACLChaincode("aclch", "aclcc")}, owner.Address())
+
+	stub := ledgerMock.GetStub(testTokenCCName)
+	delete(stub.Invokables, "acl/acl")
+	stub.MockPeerChaincodeWithChannel("aclcc", ledgerMock.GetStub("acl"), "aclch")
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+	user1.BalanceShouldBe(testTokenCCName, 1000)
+}
+
+// TestStateACLProvider - Checking that chaincode works without ACL chaincode using the state ACL provider
+func TestStateACLProvider(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCodeWithOptions(testTokenCCName, tt, &core.ContractOptions{},
+		[]core.ChaincodeOption{core.WithACLProvider(&helpers.StateACLProvider{})}, owner.Address())
+
+	stub := ledgerMock.GetStub(testTokenCCName)
+	delete(stub.Invokables, "acl/acl")
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+	user1.BalanceShouldBe(testTokenCCName, 1000)
+
+	t.Run("blacklisted sender", func(t *testing.T) {
+		stub.MockTransactionStart("blacklist")
+		err := helpers.PutStateACLAccountInfo(stub, owner.Address(), &proto.AccountInfo{BlackListed: true})
+		stub.MockTransactionEnd("blacklist")
+		assert.NoError(t, err)
+
+		err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+		assert.EqualError(t, err, "address "+owner.Address()+" is blacklisted")
+		user1.BalanceShouldBe(testTokenCCName, 1000)
+	})
+}
@@ -0,0 +1,152 @@
+package unit
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/atomyze-foundation/foundation/token"
+	pb "github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+func (tt *TestToken) NBTxTokenBalanceSub(sender *types.Sender, amount *big.Int) error {
+	return tt.TokenBalanceSub(sender.Address(), amount, "nbBurn")
+}
+
+func balanceChangesOf(t *testing.T, payload []byte) []*proto.BalanceChange {
+	changes := &proto.BalanceChanges{}
+	assert.NoError(t, pb.Unmarshal(payload, changes))
+	return changes.Changes
+}
+
+// TestBalanceChangeEvents - Checking that balance changes are emitted in batched and non-batched transactions
+func TestBalanceChangeEvents(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+
+	t.Run("emission", func(t *testing.T) {
+		_, resp, _ := owner.RawSignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+		assert.Empty(t, resp.Error)
+
+		changes := balanceChangesOf(t, resp.Events[core.BalanceChangesEvent])
+		assert.Len(t, changes, 1)
+		assert.Equal(t, testTokenSymbol, changes[0].Token)
+		assert.Equal(t, proto.BalanceChange_TOKEN, changes[0].ToKind)
+		assert.Empty(t, changes[0].From)
+		assert.Equal(t, user1.AddressType().Bytes(), changes[0].To)
+		assert.Equal(t, "1000", new(big.Int).SetBytes(changes[0].Amount).String())
+		assert.Equal(t, "1000", new(big.Int).SetBytes(changes[0].ToBalance).String())
+		assert.Equal(t, "txEmit", changes[0].Reason)
+	})
+
+	t.Run("industrial lock", func(t *testing.T) {
+		owner.SignedInvoke(testTokenCCName, "industrialBalanceAdd", testTokenWithGroup, user1.Address(), "500", "add")
+		_, resp, _ := owner.RawSignedInvoke(testTokenCCName, "industrialBalanceLock", testTokenWithGroup, user1.Address(), "200")
+		assert.Empty(t, resp.Error)
+
+		changes := balanceChangesOf(t, resp.Events[core.BalanceChangesEvent])
+		assert.Len(t, changes, 1)
+		assert.Equal(t, testTokenSymbol+"_"+testGroup, changes[0].Token)
+		assert.Equal(t, proto.BalanceChange_INDUSTRIAL, changes[0].FromKind)
+		assert.Equal(t, proto.BalanceChange_LOCKED_INDUSTRIAL, changes[0].ToKind)
+		assert.Equal(t, changes[0].From, changes[0].To)
+		assert.Equal(t, "300", new(big.Int).SetBytes(changes[0].FromBalance).String())
+		assert.Equal(t, "200", new(big.Int).SetBytes(changes[0].ToBalance).String())
+	})
+
+	t.Run("non-batched transaction", func(t *testing.T) {
+		_, err := user1.SignedNbInvoke(testTokenCCName, "tokenBalanceSub", "100")
+		assert.NoError(t, err)
+
+		event := ledgerMock.LastEvent(testTokenCCName)
+		assert.NotNil(t, event)
+		assert.Equal(t, core.BalanceChangesEvent, event.EventName)
+
+		changes := balanceChangesOf(t, event.Payload)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, user1.AddressType().Bytes(), changes[0].From)
+		assert.Empty(t, changes[0].To)
+		assert.Equal(t, "900", new(big.Int).SetBytes(changes[0].FromBalance).String())
+		assert.Equal(t, "nbBurn", changes[0].Reason)
+		user1.BalanceShouldBe(testTokenCCName, 900)
+	})
+
+	t.Run("failed non-batched transaction", func(t *testing.T) {
+		_, err := user1.SignedNbInvoke(testTokenCCName, "tokenBalanceSub", "100000")
+		assert.Error(t, err)
+		assert.Nil(t, ledgerMock.LastEvent(testTokenCCName))
+	})
+}
+
+// TestBalanceChangeEventsSwapDone - Checking that swapDone delivers its key event inside the BalanceChanges event
+func TestBalanceChangeEventsSwapDone(t *testing.T) {
+	m := mock.NewLedger(t)
+	owner := m.NewWallet()
+	cc := token.BaseToken{Symbol: "CC"}
+	m.NewChainCode("cc", &cc, nil, nil, owner.Address())
+	vt := token.BaseToken{Symbol: "VT"}
+	m.NewChainCode("vt", &vt, nil, nil, owner.Address())
+
+	user1 := m.NewWallet()
+	user1.AddAllowedBalance("vt", "CC", 1000)
+	user1.AddGivenBalance("cc", "VT", 1000)
+
+	swapKey := "123"
+	hashed := sha3.Sum256([]byte(swapKey))
+	txID := user1.SignedInvoke("vt", "swapBegin", "CC", "CC", "450", hex.EncodeToString(hashed[:]))
+	m.WaitSwapAnswer("cc", txID, time.Second*5)
+
+	user1.Invoke("cc", "swapDone", txID, swapKey)
+	event := m.LastEvent("cc")
+	assert.NotNil(t, event)
+	assert.Equal(t, core.BalanceChangesEvent, event.EventName)
+
+	changes := &proto.BalanceChanges{}
+	assert.NoError(t, pb.Unmarshal(event.Payload, changes))
+	assert.Equal(t, "key", changes.EventName)
+	assert.Contains(t, string(changes.EventPayload), swapKey)
+	assert.Len(t, changes.Changes, 1)
+	assert.Equal(t, user1.AddressType().Bytes(), changes.Changes[0].To)
+	assert.Equal(t, "450", new(big.Int).SetBytes(changes.Changes[0].ToBalance).String())
+}
+
+// TestBalanceChangeEventsGivenBalance - Checking that changes of given balances are emitted without an address
+func TestBalanceChangeEventsGivenBalance(t *testing.T) {
+	m := mock.NewLedger(t)
+	owner := m.NewWallet()
+	cc := token.BaseToken{Symbol: "CC"}
+	m.NewChainCode("cc", &cc, nil, nil, owner.Address())
+
+	user1 := m.NewWallet()
+	user1.AddBalance("cc", 1000)
+
+	_, resp, _ := user1.RawSignedInvoke("cc", "channelTransferByCustomer", uuid.NewString(), "VT", "CC", "450")
+	assert.Empty(t, resp.Error)
+
+	changes := balanceChangesOf(t, resp.Events[core.BalanceChangesEvent])
+	assert.Len(t, changes, 2)
+	assert.Equal(t, proto.BalanceChange_TOKEN, changes[0].FromKind)
+	assert.Equal(t, "550", new(big.Int).SetBytes(changes[0].FromBalance).String())
+	assert.Equal(t, "VT", changes[1].Token)
+	assert.Equal(t, proto.BalanceChange_GIVEN, changes[1].ToKind)
+	assert.Empty(t, changes[1].To)
+	assert.Equal(t, "450", new(big.Int).SetBytes(changes[1].ToBalance).String())
+}
@@ -1,7 +1,10 @@
 package unit
 
 import (
+	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/atomyze-foundation/foundation/core"
@@ -90,6 +93,41 @@ func TestGetNonce(t *testing.T) {
 	})
 }
 
+// TestGetNonceWindow - Checking that nonce window contains used nonce and nonce check doesn't accept it again
+func TestGetNonceWindow(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{NonceTTL: 50}, nil, owner.Address())
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", owner.Address(), "1000")
+	nonce := strings.Trim(owner.Invoke(testTokenCCName, testGetNonceFnName, owner.Address()), "\"")
+
+	window := &core.NonceWindow{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke(testTokenCCName, "getNonceWindow", owner.Address())), window))
+	assert.Equal(t, "nonce", window.Prefix)
+	assert.Equal(t, uint(50), window.TTL)
+	assert.Len(t, window.Nonces, 1)
+	assert.Equal(t, nonce, strconv.FormatUint(window.Last, 10))
+	assert.Equal(t, window.Last-50000, window.Min)
+
+	result := &core.NonceCheckResult{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke(testTokenCCName, "checkNonce", owner.Address(), nonce)), result))
+	assert.False(t, result.Accepted)
+	assert.Equal(t, "nonce "+nonce+" already exists", result.Reason)
+
+	next := strconv.FormatUint(window.Last+1, 10)
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke(testTokenCCName, "checkNonce", owner.Address(), next)), result))
+	assert.True(t, result.Accepted)
+}
+
 // TestInit - Checking that init with right mspId working
 func TestInit(t *testing.T) {
 	ledgerMock := mock.NewLedger(t)
@@ -8,6 +8,7 @@ import (
 	"github.com/atomyze-foundation/foundation/mock"
 	"github.com/atomyze-foundation/foundation/proto"
 	"github.com/atomyze-foundation/foundation/token"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -315,3 +316,313 @@ func TestFailedUnlock(t *testing.T) {
 	err = owner.RawSignedInvokeWithErrorReturned("cc", "unlockAllowedBalance", string(data2))
 	assert.EqualError(t, err, "amount should be positive")
 }
+
+func TestExpiringLockExecuteRelease(t *testing.T) {
+	m := mock.NewLedger(t)
+	owner := m.NewWallet()
+	cc := token.BaseToken{
+		Symbol: "CC",
+	}
+	m.NewChainCode("cc", &cc, &core.ContractOptions{}, nil, owner.Address())
+
+	user1 := m.NewWallet()
+	user2 := m.NewWallet()
+	user3 := m.NewWallet()
+	user1.AddBalance("cc", 1000)
+	user1.AddAllowedBalance("cc", "vt", 1000)
+
+	now := m.GetStub("cc").TxTimestamp.Seconds
+
+	request1 := &proto.BalanceLockRequest{
+		Address:     user1.Address(),
+		Token:       "cc",
+		Amount:      "600",
+		Reason:      "escrow",
+		ExpiresAt:   now - 1,
+		Beneficiary: user2.Address(),
+	}
+	data1, err := json.Marshal(request1)
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned("cc", "lockTokenBalance", string(data1))
+	assert.EqualError(t, err, core.ErrLockExpiresAt.Error())
+
+	request1.ExpiresAt = now + 100
+	data1, err = json.Marshal(request1)
+	assert.NoError(t, err)
+	request1.Id = owner.SignedInvoke("cc", "lockTokenBalance", string(data1))
+
+	// the allowed balance lock doesn't have a beneficiary
+	request2 := &proto.BalanceLockRequest{
+		Address:   user1.Address(),
+		Token:     "vt",
+		Amount:    "600",
+		Reason:    "court order",
+		ExpiresAt: now + 100,
+	}
+	data2, err := json.Marshal(request2)
+	assert.NoError(t, err)
+	request2.Id = owner.SignedInvoke("cc", "lockAllowedBalance", string(data2))
+
+	err = user3.InvokeWithError("cc", "releaseExpiredTokenBalanceLock", request1.Id)
+	assert.EqualError(t, err, core.ErrLockNotExpired.Error())
+
+	// execution by anyone except the admin fails
+	request1.Amount = "200"
+	data1, err = json.Marshal(request1)
+	assert.NoError(t, err)
+	err = user2.RawSignedInvokeWithErrorReturned("cc", "executeTokenBalanceLock", string(data1))
+	assert.EqualError(t, err, core.ErrPlatformAdminOnly.Error())
+
+	// the address and the token of the request must match the lock
+	for _, mismatch := range []*proto.BalanceLockRequest{
+		{Id: request1.Id, Address: user3.Address(), Token: "cc", Amount: "200", Reason: "escrow"},
+		{Id: request1.Id, Address: user1.Address(), Token: "vt", Amount: "200", Reason: "escrow"},
+	} {
+		data, err := json.Marshal(mismatch)
+		assert.NoError(t, err)
+		err = owner.RawSignedInvokeWithErrorReturned("cc", "executeTokenBalanceLock", string(data))
+		assert.EqualError(t, err, core.ErrLockMismatch.Error())
+	}
+
+	// zero and negative amounts can't be executed
+	for _, amount := range []string{"0", "-100"} {
+		request := &proto.BalanceLockRequest{
+			Id: request1.Id, Address: user1.Address(), Token: "cc", Amount: amount, Reason: "escrow",
+		}
+		data, err := json.Marshal(request)
+		assert.NoError(t, err)
+		err = owner.RawSignedInvokeWithErrorReturned("cc", "executeTokenBalanceLock", string(data))
+		assert.EqualError(t, err, core.ErrLockAmountNotPositive.Error())
+	}
+
+	owner.SignedInvoke("cc", "executeTokenBalanceLock", string(data1))
+	user1.BalanceShouldBe("cc", 400)
+	user2.BalanceShouldBe("cc", 200)
+
+	request2.Amount = "100"
+	data2, err = json.Marshal(request2)
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned("cc", "executeAllowedBalanceLock", string(data2))
+	assert.EqualError(t, err, core.ErrBeneficiaryRequired.Error())
+
+	request2.Beneficiary = user3.Address()
+	request2.Amount = "0"
+	data2, err = json.Marshal(request2)
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned("cc", "executeAllowedBalanceLock", string(data2))
+	assert.EqualError(t, err, core.ErrLockAmountNotPositive.Error())
+
+	request2.Amount = "100"
+	request2.Token = "cc"
+	data2, err = json.Marshal(request2)
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned("cc", "executeAllowedBalanceLock", string(data2))
+	assert.EqualError(t, err, core.ErrLockMismatch.Error())
+
+	request2.Token = "vt"
+	data2, err = json.Marshal(request2)
+	assert.NoError(t, err)
+	owner.SignedInvoke("cc", "executeAllowedBalanceLock", string(data2))
+	user1.AllowedBalanceShouldBe("cc", "vt", 400)
+	user3.AllowedBalanceShouldBe("cc", "vt", 100)
+
+	balanceLock := &proto.AllowedBalanceLock{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke("cc", "getLockedAllowedBalance", request2.Id)), balanceLock))
+	assert.Equal(t, "500", balanceLock.CurrentAmount)
+	assert.Empty(t, balanceLock.Beneficiary)
+
+	m.GetStub("cc").SetTxTimestamp(&timestamp.Timestamp{Seconds: now + 100})
+
+	err = owner.RawSignedInvokeWithErrorReturned("cc", "executeTokenBalanceLock", string(data1))
+	assert.EqualError(t, err, core.ErrLockExpired.Error())
+
+	user3.Invoke("cc", "releaseExpiredTokenBalanceLock", request1.Id)
+	user3.Invoke("cc", "releaseExpiredAllowedBalanceLock", request2.Id)
+	user1.BalanceShouldBe("cc", 800)
+	user1.AllowedBalanceShouldBe("cc", "vt", 900)
+
+	err = owner.InvokeWithError("cc", "getLockedTokenBalance", request1.Id)
+	assert.ErrorContains(t, err, core.ErrLockNotExists.Error())
+	err = owner.InvokeWithError("cc", "getLockedAllowedBalance", request2.Id)
+	assert.ErrorContains(t, err, core.ErrLockNotExists.Error())
+}
+
+func TestLockQueries(t *testing.T) {
+	m := mock.NewLedger(t)
+	owner := m.NewWallet()
+	cc := token.BaseToken{
+		Symbol: "CC",
+	}
+	m.NewChainCode("cc", &cc, &core.ContractOptions{}, nil, owner.Address())
+
+	user1 := m.NewWallet()
+	user2 := m.NewWallet()
+	user1.AddBalance("cc", 1000)
+	user1.AddAllowedBalance("cc", "vt", 1000)
+	user2.AddBalance("cc", 1000)
+
+	lock := func(fn string, req *proto.BalanceLockRequest) string {
+		data, err := json.Marshal(req)
+		assert.NoError(t, err)
+		return owner.SignedInvoke("cc", fn, string(data))
+	}
+	query := func(fn string, args ...string) *core.Locks {
+		locks := &core.Locks{}
+		assert.NoError(t, json.Unmarshal([]byte(owner.Invoke("cc", fn, args...)), locks))
+		return locks
+	}
+
+	id1 := lock("lockTokenBalance", &proto.BalanceLockRequest{Address: user1.Address(), Token: "cc", Amount: "100", Reason: "court order", Tag: "case-1"})
+	id2 := lock("lockAllowedBalance", &proto.BalanceLockRequest{Address: user1.Address(), Token: "vt", Amount: "200", Reason: "escrow"})
+	id3 := lock("lockTokenBalance", &proto.BalanceLockRequest{Address: user2.Address(), Token: "cc", Amount: "300", Reason: "court order", Tag: "case-1"})
+
+	t.Run("by address with pages", func(t *testing.T) {
+		page := query("locksByAddress", user1.Address(), "1", "")
+		assert.Len(t, page.Locks, 1)
+		assert.NotEmpty(t, page.Bookmark)
+		found := []*core.LockInfo{page.Locks[0]}
+
+		page = query("locksByAddress", user1.Address(), "1", page.Bookmark)
+		assert.Len(t, page.Locks, 1)
+		found = append(found, page.Locks[0])
+
+		byID := map[string]*core.LockInfo{}
+		for _, l := range found {
+			byID[l.ID] = l
+		}
+		assert.Equal(t, core.LockKindToken, byID[id1].Kind)
+		assert.Equal(t, "court order", byID[id1].Reason)
+		assert.Equal(t, core.LockKindAllowed, byID[id2].Kind)
+		assert.Equal(t, "200", byID[id2].InitAmount)
+		assert.Equal(t, "200", byID[id2].CurrentAmount)
+
+		err := owner.InvokeWithError("cc", "locksByAddress", user1.Address(), "1", "wrong")
+		assert.EqualError(t, err, core.ErrLockBookmark.Error())
+		err = owner.InvokeWithError("cc", "locksByAddress", user1.Address(), "0", "")
+		assert.EqualError(t, err, core.ErrLockPageSize.Error())
+	})
+
+	t.Run("by token, reason and tag", func(t *testing.T) {
+		assert.Len(t, query("locksByToken", "cc", "10", "").Locks, 2)
+		assert.Len(t, query("locksByToken", "vt", "10", "").Locks, 1)
+		assert.Len(t, query("locksByReason", "court order", "10", "").Locks, 2)
+		assert.Len(t, query("locksByTag", "case-1", "10", "").Locks, 2)
+		assert.Empty(t, query("locksByTag", "case-2", "10", "").Locks)
+	})
+
+	t.Run("partially and fully unlocked", func(t *testing.T) {
+		data, err := json.Marshal(&proto.BalanceLockRequest{Id: id3, Address: user2.Address(), Token: "cc", Amount: "100", Reason: "court order"})
+		assert.NoError(t, err)
+		owner.SignedInvoke("cc", "unlockTokenBalance", string(data))
+
+		page := query("locksByAddress", user2.Address(), "10", "")
+		assert.Len(t, page.Locks, 1)
+		assert.Equal(t, "300", page.Locks[0].InitAmount)
+		assert.Equal(t, "200", page.Locks[0].CurrentAmount)
+
+		owner.SignedInvoke("cc", "unlockTokenBalance", string(data))
+		owner.SignedInvoke("cc", "unlockTokenBalance", string(data))
+		assert.Empty(t, query("locksByAddress", user2.Address(), "10", "").Locks)
+		assert.Len(t, query("locksByTag", "case-1", "10", "").Locks, 1)
+	})
+
+	t.Run("indexing of locks without indexes", func(t *testing.T) {
+		stub := m.GetStub("cc")
+		// the lock looks like one created before the indexes were introduced
+		for _, index := range [][2]string{{"lockByAddress", user1.Address()}, {"lockByToken", "vt"}, {"lockByReason", "escrow"}} {
+			key, err := stub.CreateCompositeKey(index[0], []string{index[1], core.LockKindAllowed, id2})
+			assert.NoError(t, err)
+			assert.NoError(t, stub.DelState(key))
+		}
+		assert.Len(t, query("locksByAddress", user1.Address(), "10", "").Locks, 1)
+
+		_, err := user1.SignedNbInvoke("cc", "indexLocks")
+		assert.EqualError(t, err, core.ErrPlatformAdminOnly.Error())
+
+		indexed, err := owner.SignedNbInvoke("cc", "indexLocks")
+		assert.NoError(t, err)
+		assert.Equal(t, "1", indexed)
+		indexed, err = owner.SignedNbInvoke("cc", "indexLocks")
+		assert.NoError(t, err)
+		assert.Equal(t, "0", indexed)
+
+		assert.Len(t, query("locksByAddress", user1.Address(), "10", "").Locks, 2)
+		assert.Len(t, query("locksByReason", "escrow", "10", "").Locks, 1)
+	})
+}
+
+func TestIndustrialLockUnlockExecute(t *testing.T) {
+	m := mock.NewLedger(t)
+	owner := m.NewWallet()
+	cc := token.BaseToken{
+		Symbol: "CC",
+	}
+	m.NewChainCode("cc", &cc, &core.ContractOptions{}, nil, owner.Address())
+
+	user1 := m.NewWallet()
+	user2 := m.NewWallet()
+	user1.AddTokenBalance("cc", "cc_202401", 1000)
+
+	request := &proto.BalanceLockRequest{
+		Address: user1.Address(),
+		Token:   "cc",
+		Amount:  "600",
+		Reason:  "court order",
+	}
+	data, err := json.Marshal(request)
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned("cc", "lockIndustrialBalance", string(data))
+	assert.EqualError(t, err, core.ErrIndustrialTokenRequired.Error())
+
+	err = user1.RawSignedInvokeWithErrorReturned("cc", "lockIndustrialBalance", string(data))
+	assert.EqualError(t, err, core.ErrPlatformAdminOnly.Error())
+
+	// the industrial token of another contract can't be locked
+	request.Token = "vt_202401"
+	data, err = json.Marshal(request)
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned("cc", "lockIndustrialBalance", string(data))
+	assert.EqualError(t, err, core.ErrIndustrialTokenRequired.Error())
+
+	request.Token = "cc_202401"
+	request.Tag = "case-1"
+	data, err = json.Marshal(request)
+	assert.NoError(t, err)
+	request.Id = owner.SignedInvoke("cc", "lockIndustrialBalance", string(data))
+	user1.GroupBalanceShouldBe("cc", "202401", 400)
+
+	lock := &proto.TokenBalanceLock{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke("cc", "getLockedIndustrialBalance", request.Id)), lock))
+	assert.Equal(t, "cc_202401", lock.Token)
+	assert.Equal(t, "600", lock.CurrentAmount)
+
+	locks := &core.Locks{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke("cc", "locksByTag", "case-1", "10", "")), locks))
+	assert.Len(t, locks.Locks, 1)
+	assert.Equal(t, core.LockKindIndustrial, locks.Locks[0].Kind)
+
+	request.Amount = "100"
+	data, err = json.Marshal(request)
+	assert.NoError(t, err)
+	owner.SignedInvoke("cc", "unlockIndustrialBalance", string(data))
+	user1.GroupBalanceShouldBe("cc", "202401", 500)
+
+	request.Amount = "0"
+	request.Beneficiary = user2.Address()
+	data, err = json.Marshal(request)
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned("cc", "executeIndustrialBalanceLock", string(data))
+	assert.EqualError(t, err, core.ErrLockAmountNotPositive.Error())
+
+	request.Amount = "500"
+	data, err = json.Marshal(request)
+	assert.NoError(t, err)
+	owner.SignedInvoke("cc", "executeIndustrialBalanceLock", string(data))
+	user1.GroupBalanceShouldBe("cc", "202401", 500)
+	user2.GroupBalanceShouldBe("cc", "202401", 500)
+
+	err = owner.InvokeWithError("cc", "getLockedIndustrialBalance", request.Id)
+	assert.ErrorContains(t, err, core.ErrLockNotExists.Error())
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke("cc", "locksByTag", "case-1", "10", "")), locks))
+	assert.Empty(t, locks.Locks)
+}
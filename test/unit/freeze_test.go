@@ -0,0 +1,130 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAddressFreeze - Checking that frozen addresses can't send or receive tokens depending on the freeze mode
+func TestAddressFreeze(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	officer := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+	user2 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user2.Address(), "1000")
+
+	err := officer.RawSignedInvokeWithErrorReturned(testTokenCCName, "freezeAddress", user1.Address(), core.FreezeFull, "investigation")
+	assert.EqualError(t, err, core.ErrFreezeAdminOnly.Error())
+
+	owner.SignedInvoke(testTokenCCName, "setComplianceOfficer", officer.Address())
+	err = officer.RawSignedInvokeWithErrorReturned(testTokenCCName, "freezeAddress", user1.Address(), "partial", "investigation")
+	assert.EqualError(t, err, core.ErrFreezeMode.Error())
+
+	officer.SignedInvoke(testTokenCCName, "freezeAddress", user1.Address(), core.FreezeOutgoing, "investigation")
+	err = user1.RawSignedInvokeWithErrorReturned(testTokenCCName, "transfer", user2.Address(), "100", "")
+	assert.ErrorContains(t, err, core.ErrAddressFrozen.Error())
+	user2.SignedInvoke(testTokenCCName, "transfer", user1.Address(), "100", "")
+
+	officer.SignedInvoke(testTokenCCName, "freezeAddress", user1.Address(), core.FreezeIncoming, "investigation")
+	err = user2.RawSignedInvokeWithErrorReturned(testTokenCCName, "transfer", user1.Address(), "100", "")
+	assert.ErrorContains(t, err, core.ErrAddressFrozen.Error())
+	user1.SignedInvoke(testTokenCCName, "transfer", user2.Address(), "100", "")
+
+	owner.SignedInvoke(testTokenCCName, "freezeAddress", user2.Address(), core.FreezeFull, "court order")
+	err = user2.RawSignedInvokeWithErrorReturned(testTokenCCName, "transfer", owner.Address(), "100", "")
+	assert.ErrorContains(t, err, core.ErrAddressFrozen.Error())
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "emissionAdd", user2.Address(), "100")
+	assert.ErrorContains(t, err, core.ErrAddressFrozen.Error())
+
+	frozen := &core.FrozenAddresses{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke(testTokenCCName, "frozenAddresses", "10", "")), frozen))
+	assert.Len(t, frozen.Addresses, 2)
+
+	status := &core.FrozenAddress{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke(testTokenCCName, "freezeStatus", user2.Address())), status))
+	assert.Equal(t, core.FreezeFull, status.Mode)
+	assert.Equal(t, "court order", status.Reason)
+
+	officer.SignedInvoke(testTokenCCName, "unfreezeAddress", user2.Address(), "court order lifted")
+	err = officer.RawSignedInvokeWithErrorReturned(testTokenCCName, "unfreezeAddress", user2.Address(), "court order lifted")
+	assert.EqualError(t, err, core.ErrNotFrozen.Error())
+	user2.SignedInvoke(testTokenCCName, "transfer", owner.Address(), "100", "")
+
+	user1.BalanceShouldBe(testTokenCCName, 1000)
+	user2.BalanceShouldBe(testTokenCCName, 900)
+	owner.BalanceShouldBe(testTokenCCName, 100)
+	assert.Equal(t, "null", owner.Invoke(testTokenCCName, "freezeStatus", user2.Address()))
+}
+
+// TestAddressFreezeLocks - Checking that funds of frozen addresses can't be locked or unlocked,
+// but the admin executes locks of frozen owners to beneficiaries which aren't frozen
+func TestAddressFreezeLocks(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+	user2 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+
+	request := &proto.BalanceLockRequest{
+		Address: user1.Address(),
+		Token:   testTokenCCName,
+		Amount:  "600",
+		Reason:  "court order",
+	}
+	data, err := json.Marshal(request)
+	assert.NoError(t, err)
+
+	owner.SignedInvoke(testTokenCCName, "freezeAddress", user1.Address(), core.FreezeOutgoing, "investigation")
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "lockTokenBalance", string(data))
+	assert.ErrorContains(t, err, core.ErrAddressFrozen.Error())
+
+	owner.SignedInvoke(testTokenCCName, "unfreezeAddress", user1.Address(), "investigation")
+	request.Id = owner.SignedInvoke(testTokenCCName, "lockTokenBalance", string(data))
+	owner.SignedInvoke(testTokenCCName, "freezeAddress", user1.Address(), core.FreezeFull, "investigation")
+
+	request.Amount = "100"
+	data, err = json.Marshal(request)
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "unlockTokenBalance", string(data))
+	assert.ErrorContains(t, err, core.ErrAddressFrozen.Error())
+
+	// the beneficiary can't receive funds
+	request.Beneficiary = user2.Address()
+	data, err = json.Marshal(request)
+	assert.NoError(t, err)
+	owner.SignedInvoke(testTokenCCName, "freezeAddress", user2.Address(), core.FreezeIncoming, "investigation")
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "executeTokenBalanceLock", string(data))
+	assert.ErrorContains(t, err, core.ErrAddressFrozen.Error())
+
+	owner.SignedInvoke(testTokenCCName, "unfreezeAddress", user2.Address(), "investigation")
+	owner.SignedInvoke(testTokenCCName, "executeTokenBalanceLock", string(data))
+	user1.BalanceShouldBe(testTokenCCName, 400)
+	user2.BalanceShouldBe(testTokenCCName, 100)
+}
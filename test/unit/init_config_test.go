@@ -0,0 +1,74 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/initialize"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/stretchr/testify/assert"
+)
+
+// ConfigToken is a token with the option in its init schema
+type ConfigToken struct {
+	token.BaseToken
+}
+
+func (ct *ConfigToken) InitSchema() *initialize.Schema {
+	schema := ct.BaseToken.InitSchema()
+	schema.Options = []initialize.Field{{Name: "maxHolders", Type: initialize.FieldUint}}
+	return schema
+}
+
+func (ct *ConfigToken) QueryInitConfig() ([]string, error) {
+	config, err := initialize.LoadInitArgs(ct.GetStub())
+	if err != nil {
+		return nil, err
+	}
+	feeSetter, err := config.Address("feeSetter")
+	if err != nil {
+		return nil, err
+	}
+	return []string{feeSetter.String(), config.Option("maxHolders")}, nil
+}
+
+// TestNamedInitConfig - Checking that the named init config is validated by the schema and mapped to init args
+func TestNamedInitConfig(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	feeSetter := ledgerMock.NewWallet()
+	user := ledgerMock.NewWallet()
+
+	newToken := func() *ConfigToken {
+		return &ConfigToken{token.BaseToken{Name: testTokenName, Symbol: testTokenSymbol, Decimals: 8}}
+	}
+
+	message := ledgerMock.NewChainCodeWithInitConfig("cfg1", newToken(), &core.ContractOptions{}, map[string]interface{}{
+		"feeSetter": feeSetter.Address(),
+	})
+	assert.Contains(t, message, "init config field issuer: required")
+
+	message = ledgerMock.NewChainCodeWithInitConfig("cfg2", newToken(), &core.ContractOptions{}, map[string]interface{}{
+		"issuer": owner.Address(), "feeSeter": feeSetter.Address(),
+	})
+	assert.Contains(t, message, "init config field feeSeter: unknown field")
+
+	message = ledgerMock.NewChainCodeWithInitConfig("cfg3", newToken(), &core.ContractOptions{}, map[string]interface{}{
+		"issuer": owner.Address(), "options": map[string]string{"maxHolders": "many"},
+	})
+	assert.Contains(t, message, "init config field maxHolders: many isn't uint")
+
+	// misordered positional args are rejected
+	message = ledgerMock.NewChainCode("cfg4", newToken(), &core.ContractOptions{}, nil, owner.Address(), "fee setter")
+	assert.Contains(t, message, "init arg 1: init config field feeSetter")
+
+	message = ledgerMock.NewChainCodeWithInitConfig(testTokenCCName, newToken(), &core.ContractOptions{}, map[string]interface{}{
+		"issuer": owner.Address(), "feeSetter": feeSetter.Address(), "options": map[string]string{"maxHolders": "10"},
+	})
+	assert.Empty(t, message)
+
+	assert.Equal(t, `["`+feeSetter.Address()+`","10"]`, user.Invoke(testTokenCCName, "initConfig"))
+	owner.SignedInvoke(testTokenCCName, "emit", owner.Address(), "100")
+	owner.BalanceShouldBe(testTokenCCName, 100)
+}
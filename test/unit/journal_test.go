@@ -0,0 +1,136 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func addressHistory(t *testing.T, w *mock.Wallet, address string, token string, pageSize string, bookmark string) *proto.JournalEntries {
+	entries := &proto.JournalEntries{}
+	assert.NoError(t, json.Unmarshal([]byte(w.Invoke(testTokenCCName, "addressHistory", address, token, pageSize, bookmark)), entries))
+	return entries
+}
+
+// TestAddressHistory - Checking that balance changes are written to the per-address journal
+func TestAddressHistory(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+	user2 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{EnableJournal: true, JournalRetention: 4}, nil, owner.Address())
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+	user1.SignedInvoke(testTokenCCName, "transfer", user2.Address(), "100", "")
+
+	t.Run("pages from the newest entry", func(t *testing.T) {
+		page := addressHistory(t, owner, user1.Address(), "", "1", "")
+		assert.Len(t, page.Entries, 1)
+		assert.NotEmpty(t, page.Bookmark)
+		assert.Equal(t, uint64(2), page.Entries[0].Seq)
+		assert.False(t, page.Entries[0].Credit)
+		assert.Equal(t, user2.AddressType().Bytes(), page.Entries[0].Counterparty)
+		assert.Equal(t, "900", new(big.Int).SetBytes(page.Entries[0].Balance).String())
+		assert.Equal(t, "transfer", page.Entries[0].Reason)
+
+		page = addressHistory(t, owner, user1.Address(), "", "1", page.Bookmark)
+		assert.Len(t, page.Entries, 1)
+		assert.Empty(t, page.Bookmark)
+		assert.Equal(t, uint64(1), page.Entries[0].Seq)
+		assert.True(t, page.Entries[0].Credit)
+		assert.Empty(t, page.Entries[0].Counterparty)
+		assert.Equal(t, "1000", new(big.Int).SetBytes(page.Entries[0].Balance).String())
+
+		page = addressHistory(t, owner, user2.Address(), "", "10", "")
+		assert.Len(t, page.Entries, 1)
+		assert.True(t, page.Entries[0].Credit)
+		assert.Equal(t, "100", new(big.Int).SetBytes(page.Entries[0].Balance).String())
+	})
+
+	t.Run("token filter", func(t *testing.T) {
+		owner.SignedInvoke(testTokenCCName, "industrialBalanceAdd", testTokenWithGroup, user1.Address(), "500", "add")
+
+		page := addressHistory(t, owner, user1.Address(), testTokenSymbol+"_"+testGroup, "10", "")
+		assert.Len(t, page.Entries, 1)
+		assert.Equal(t, proto.BalanceChange_INDUSTRIAL, page.Entries[0].Kind)
+		assert.Equal(t, "add", page.Entries[0].Reason)
+
+		page = addressHistory(t, owner, user1.Address(), testTokenSymbol, "10", "")
+		assert.Len(t, page.Entries, 2)
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		user1.SignedInvoke(testTokenCCName, "transfer", user2.Address(), "1", "")
+		user1.SignedInvoke(testTokenCCName, "transfer", user2.Address(), "1", "")
+
+		page := addressHistory(t, owner, user1.Address(), "", "10", "")
+		assert.Len(t, page.Entries, 4)
+		assert.Equal(t, uint64(5), page.Entries[0].Seq)
+		assert.Equal(t, uint64(2), page.Entries[3].Seq)
+
+		page = addressHistory(t, owner, user1.Address(), testTokenSymbol, "10", "")
+		assert.Len(t, page.Entries, 3)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		err := owner.InvokeWithError(testTokenCCName, "addressHistory", user1.Address(), "", "0", "")
+		assert.EqualError(t, err, core.ErrJournalPageSize.Error())
+
+		err = owner.InvokeWithError(testTokenCCName, "addressHistory", user1.Address(), "", "10", "wrong")
+		assert.EqualError(t, err, core.ErrJournalBookmark.Error())
+	})
+
+	t.Run("pruning after the retention decreased", func(t *testing.T) {
+		ledgerMock.UpgradeChainCode(testTokenCCName, tt, &core.ContractOptions{EnableJournal: true, JournalRetention: 2})
+
+		_, err := user1.SignedNbInvoke(testTokenCCName, "pruneAddressHistory", user1.Address())
+		assert.EqualError(t, err, core.ErrJournalAdminOnly.Error())
+
+		deleted, err := owner.SignedNbInvoke(testTokenCCName, "pruneAddressHistory", user1.Address())
+		assert.NoError(t, err)
+		assert.Equal(t, "2", deleted)
+
+		page := addressHistory(t, owner, user1.Address(), "", "10", "")
+		assert.Len(t, page.Entries, 2)
+		assert.Equal(t, uint64(5), page.Entries[0].Seq)
+		assert.Equal(t, uint64(4), page.Entries[1].Seq)
+
+		page = addressHistory(t, owner, user1.Address(), testTokenSymbol+"_"+testGroup, "10", "")
+		assert.Empty(t, page.Entries)
+	})
+}
+
+// TestAddressHistoryDisabled - Checking that the journal isn't written unless it's enabled
+func TestAddressHistoryDisabled(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+
+	err := owner.InvokeWithError(testTokenCCName, "addressHistory", user1.Address(), "", "10", "")
+	assert.EqualError(t, err, core.ErrJournalDisabled.Error())
+}
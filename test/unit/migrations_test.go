@@ -0,0 +1,147 @@
+package unit
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/mock"
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/stretchr/testify/assert"
+)
+
+// MigratingToken is a token with the migration which renames the state key in two steps
+type MigratingToken struct {
+	token.BaseToken
+}
+
+func (mt *MigratingToken) Migrations() []core.Migration {
+	return append(mt.BaseToken.Migrations(), core.Migration{
+		Version: 2,
+		Name:    "rename legacy keys",
+		Step: func(bc *core.BaseContract, bookmark string, _ int) (string, uint64, error) {
+			key := "legacyA"
+			if bookmark != "" {
+				key = "legacyB"
+			}
+			data, err := bc.GetStub().GetState(key)
+			if err != nil {
+				return "", 0, err
+			}
+			if err = bc.GetStub().DelState(key); err != nil {
+				return "", 0, err
+			}
+			if err = bc.GetStub().PutState("renamed"+key[len("legacy"):], data); err != nil {
+				return "", 0, err
+			}
+			if bookmark == "" {
+				return key, 1, nil
+			}
+			return "", 1, nil
+		},
+	})
+}
+
+func migrationStatus(t *testing.T, res string) *core.MigrationStatus {
+	status := &core.MigrationStatus{}
+	assert.NoError(t, json.Unmarshal([]byte(res), status))
+	return status
+}
+
+// TestMigrations - Checking that core and contract migrations run in paginated steps and the schema version is tracked
+func TestMigrations(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	users := []*mock.Wallet{ledgerMock.NewWallet(), ledgerMock.NewWallet(), ledgerMock.NewWallet()}
+	stranger := ledgerMock.NewWallet()
+
+	mt := &MigratingToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, mt, &core.ContractOptions{}, nil, owner.Address())
+	s := ledgerMock.GetStub(testTokenCCName)
+
+	for i, user := range users {
+		putNonce(t, s, core.StateKeyNonce, user.Address(), new(big.Int).SetUint64(uint64(1660055050000+i)).Bytes())
+	}
+	preimageKey, err := s.CreateCompositeKey("batchTransactions", []string{"legacy"})
+	assert.NoError(t, err)
+	preimage, err := json.Marshal([]string{"transfer", owner.Address(), users[0].Address(), "1"})
+	assert.NoError(t, err)
+	s.MockTransactionStart("legacy")
+	assert.NoError(t, s.PutState("legacyA", []byte("a")))
+	assert.NoError(t, s.PutState("legacyB", []byte("b")))
+	assert.NoError(t, s.PutState(preimageKey, preimage))
+	s.MockTransactionEnd("legacy")
+
+	status := migrationStatus(t, owner.Invoke(testTokenCCName, "migrationStatus"))
+	assert.False(t, status.Done)
+	assert.Equal(t, []*core.PendingMigration{
+		{Version: 1, Name: "rewrite legacy raw nonces into pb.Nonce"},
+		{Version: 2, Name: "rewrite legacy JSON preimages of batched transactions into pb.PendingTx"},
+	}, status.Tracks[0].Pending)
+	assert.Equal(t, []*core.PendingMigration{
+		{Version: 1, Name: "store the token config"},
+		{Version: 2, Name: "rename legacy keys"},
+	}, status.Tracks[1].Pending)
+
+	_, err = stranger.SignedNbInvoke(testTokenCCName, "runMigrations", "2")
+	assert.EqualError(t, err, core.ErrMigrationAdminOnly.Error())
+	_, err = owner.SignedNbInvoke(testTokenCCName, "runMigrations", "0")
+	assert.EqualError(t, err, core.ErrMigrationPageSize.Error())
+
+	res, err := owner.SignedNbInvoke(testTokenCCName, "runMigrations", "2")
+	assert.NoError(t, err)
+	status = migrationStatus(t, res)
+	assert.Equal(t, uint64(0), status.Tracks[0].Version)
+	assert.Equal(t, uint64(2), status.Tracks[0].Current.Processed)
+	assert.Equal(t, uint64(1), status.Tracks[0].Current.Pages)
+
+	for i := 0; i < 10 && !status.Done; i++ {
+		res, err = owner.SignedNbInvoke(testTokenCCName, "runMigrations", "2")
+		assert.NoError(t, err)
+		status = migrationStatus(t, res)
+	}
+	assert.True(t, status.Done)
+	assert.Equal(t, uint64(2), status.Tracks[0].Version)
+	assert.Equal(t, uint64(2), status.Tracks[1].Version)
+	assert.Nil(t, status.Tracks[1].Current)
+
+	for i, user := range users {
+		key, err := s.CreateCompositeKey(hex.EncodeToString([]byte{byte(core.StateKeyNonce)}), []string{user.Address()})
+		assert.NoError(t, err)
+		nonce := &pb.Nonce{}
+		assert.NoError(t, proto.Unmarshal(s.State[key], nonce))
+		assert.Equal(t, []uint64{uint64(1660055050000 + i)}, nonce.Nonce)
+	}
+	assert.Equal(t, []byte("a"), s.State["renamedA"])
+	assert.Equal(t, []byte("b"), s.State["renamedB"])
+	assert.Empty(t, s.State["legacyA"])
+
+	pending := &pb.PendingTx{}
+	assert.NoError(t, proto.Unmarshal(s.State[preimageKey], pending))
+	assert.Equal(t, "transfer", pending.Method)
+	assert.Equal(t, []string{users[0].Address(), "1"}, pending.Args)
+
+	config := &pb.Token{}
+	assert.NoError(t, proto.Unmarshal(s.State["tokenMetadata"], config))
+	assert.Equal(t, uint64(1), config.Version)
+
+	// raw nonces aren't read after the migration
+	putNonce(t, s, core.StateKeyNonce, users[0].Address(), new(big.Int).SetUint64(1660055050000).Bytes())
+	err = users[0].RawSignedInvokeWithErrorReturned(testTokenCCName, "transfer", users[1].Address(), "1", "")
+	assert.ErrorContains(t, err, core.ErrLegacyNonce.Error())
+
+	// nothing is left to run
+	res, err = owner.SignedNbInvoke(testTokenCCName, "runMigrations", "2")
+	assert.NoError(t, err)
+	assert.True(t, migrationStatus(t, res).Done)
+}
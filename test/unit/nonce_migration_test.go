@@ -0,0 +1,118 @@
+package unit
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/mock/stub"
+	pb "github.com/atomyze-foundation/foundation/proto"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/stretchr/testify/assert"
+)
+
+func putNonce(t *testing.T, s *stub.Stub, prefix core.StateKey, addr string, data []byte) {
+	key, err := s.CreateCompositeKey(hex.EncodeToString([]byte{byte(prefix)}), []string{addr})
+	assert.NoError(t, err)
+	s.MockTransactionStart("nonce")
+	assert.NoError(t, s.PutState(key, data))
+	s.MockTransactionEnd("nonce")
+}
+
+func getNonceWindow(t *testing.T, w *mock.Wallet, ch string, addr string) *core.NonceWindow {
+	window := &core.NonceWindow{}
+	assert.NoError(t, json.Unmarshal([]byte(w.Invoke(ch, "getNonceWindow", addr)), window))
+	return window
+}
+
+// TestNonceMigration - Checking that legacy nonces of the "passedNonce" prefix are moved to the "nonce" prefix page by page
+func TestNonceMigration(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	users := []*mock.Wallet{ledgerMock.NewWallet(), ledgerMock.NewWallet(), ledgerMock.NewWallet()}
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{NonceTTL: 50}, nil, owner.Address())
+	s := ledgerMock.GetStub(testTokenCCName)
+
+	for i, user := range users {
+		legacy := new(big.Int).SetUint64(uint64(1660055050000 + i)).Bytes()
+		putNonce(t, s, core.StateKeyPassedNonce, user.Address(), legacy)
+	}
+	data, err := proto.Marshal(&pb.Nonce{Nonce: []uint64{1660055040000}})
+	assert.NoError(t, err)
+	putNonce(t, s, core.StateKeyNonce, users[0].Address(), data)
+
+	t.Run("not admin", func(t *testing.T) {
+		_, err := users[0].SignedNbInvoke(testTokenCCName, "migrateNonces", "passedNonce", "nonce", "2")
+		assert.EqualError(t, err, core.ErrNonceMigrationAdminOnly.Error())
+	})
+
+	progress := &core.NonceMigrationProgress{}
+	res, err := owner.SignedNbInvoke(testTokenCCName, "migrateNonces", "passedNonce", "nonce", "2")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal([]byte(res), progress))
+	assert.Equal(t, uint64(2), progress.Processed)
+	assert.False(t, progress.Done)
+
+	res, err = owner.SignedNbInvoke(testTokenCCName, "migrateNonces", "passedNonce", "nonce", "2")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal([]byte(res), progress))
+	assert.Equal(t, &core.NonceMigrationProgress{
+		From:      "passedNonce",
+		To:        "nonce",
+		Processed: 3,
+		Converted: 3,
+		Merged:    1,
+		Pages:     2,
+		Bookmark:  progress.Bookmark,
+		Done:      true,
+	}, progress)
+
+	stored := &core.NonceMigrationProgress{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke(testTokenCCName, "nonceMigrationProgress", "passedNonce", "nonce")), stored))
+	assert.Equal(t, progress, stored)
+
+	for i, user := range users {
+		window := getNonceWindow(t, owner, testTokenCCName, user.Address())
+		assert.False(t, window.Legacy)
+		assert.Equal(t, uint64(1660055050000+i), window.Last)
+	}
+	assert.Equal(t, []uint64{1660055040000, 1660055050000}, getNonceWindow(t, owner, testTokenCCName, users[0].Address()).Nonces)
+}
+
+// TestDisableLegacyNonce - Checking that nonce stored as raw bytes is rejected if legacy nonces are disabled
+func TestDisableLegacyNonce(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{DisableLegacyNonce: true}, nil, owner.Address())
+	s := ledgerMock.GetStub(testTokenCCName)
+	putNonce(t, s, core.StateKeyNonce, user.Address(), new(big.Int).SetUint64(1660055050000).Bytes())
+
+	err := user.InvokeWithError(testTokenCCName, "getNonce", user.Address())
+	assert.ErrorContains(t, err, core.ErrLegacyNonce.Error())
+
+	_, err = owner.SignedNbInvoke(testTokenCCName, "migrateNonces", "nonce", "nonce", "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "\"1660055050000\"", user.Invoke(testTokenCCName, "getNonce", user.Address()))
+	assert.Len(t, getNonceWindow(t, owner, testTokenCCName, user.Address()).Nonces, 1)
+}
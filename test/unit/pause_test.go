@@ -0,0 +1,123 @@
+package unit
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+func pauseStatus(t *testing.T, res string) *core.PauseStatus {
+	status := &core.PauseStatus{}
+	assert.NoError(t, json.Unmarshal([]byte(res), status))
+	return status
+}
+
+// TestPause - Checking that the global and per-method pause block Tx and NBTx methods but not queries
+func TestPause(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+	user2 := ledgerMock.NewWallet()
+	stranger := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+
+	_, err := stranger.SignedNbInvoke(testTokenCCName, "pause", "", "false", "false", "incident")
+	assert.EqualError(t, err, core.ErrPauseAdminOnly.Error())
+	_, err = owner.SignedNbInvoke(testTokenCCName, "pause", "transfer,unknown", "false", "false", "incident")
+	assert.ErrorContains(t, err, core.ErrPauseMethod.Error())
+	_, err = owner.SignedNbInvoke(testTokenCCName, "pause", "unpause", "false", "false", "incident")
+	assert.ErrorContains(t, err, core.ErrPauseMethod.Error())
+	_, err = owner.SignedNbInvoke(testTokenCCName, "pause", "createCCTransferTo", "false", "false", "incident")
+	assert.ErrorContains(t, err, core.ErrPauseMethod.Error())
+
+	_, err = owner.SignedNbInvoke(testTokenCCName, "pause", "transfer", "false", "false", "incident")
+	assert.NoError(t, err)
+	assert.Equal(t, core.PausedEvent, ledgerMock.LastEvent(testTokenCCName).EventName)
+	err = user1.RawSignedInvokeWithErrorReturned(testTokenCCName, "transfer", user2.Address(), "100", "")
+	assert.ErrorContains(t, err, core.ErrPaused.Error())
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user2.Address(), "100")
+
+	_, err = owner.SignedNbInvoke(testTokenCCName, "pause", "", "false", "false", "incident")
+	assert.NoError(t, err)
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "emissionAdd", user2.Address(), "100")
+	assert.ErrorContains(t, err, core.ErrPaused.Error())
+	_, err = owner.SignedNbInvoke(testTokenCCName, "runMigrations", "10")
+	assert.ErrorContains(t, err, core.ErrPaused.Error())
+	owner.SignedInvoke(testTokenCCName, "freezeAddress", stranger.Address(), core.FreezeFull, "incident")
+	owner.SignedInvoke(testTokenCCName, "unfreezeAddress", stranger.Address(), "resolved")
+	owner.SignedInvoke(testTokenCCName, "proposeRole", core.RoleFeeSetter, user2.Address())
+	user2.SignedInvoke(testTokenCCName, "acceptRole", core.RoleFeeSetter)
+
+	status := pauseStatus(t, user1.Invoke(testTokenCCName, "pauseStatus"))
+	assert.True(t, status.Paused)
+	assert.True(t, status.Global)
+	assert.Equal(t, "incident", status.Reason)
+	user1.BalanceShouldBe(testTokenCCName, 1000)
+
+	_, err = owner.SignedNbInvoke(testTokenCCName, "unpause", "resolved")
+	assert.NoError(t, err)
+	assert.Equal(t, core.UnpausedEvent, ledgerMock.LastEvent(testTokenCCName).EventName)
+	_, err = owner.SignedNbInvoke(testTokenCCName, "unpause", "resolved")
+	assert.EqualError(t, err, core.ErrNotPaused.Error())
+	assert.False(t, pauseStatus(t, user1.Invoke(testTokenCCName, "pauseStatus")).Paused)
+
+	user1.SignedInvoke(testTokenCCName, "transfer", user2.Address(), "100", "")
+	user1.BalanceShouldBe(testTokenCCName, 900)
+	user2.BalanceShouldBe(testTokenCCName, 200)
+}
+
+// TestPauseSwapAnswers - Checking that swap answers are rejected only if the pause is configured to block them
+// and swapDone is rejected during the global pause
+func TestPauseSwapAnswers(t *testing.T) {
+	m := mock.NewLedger(t)
+	owner := m.NewWallet()
+	cc := token.BaseToken{Symbol: "CC"}
+	m.NewChainCode("cc", &cc, nil, nil, owner.Address())
+	vt := token.BaseToken{Symbol: "VT"}
+	m.NewChainCode("vt", &vt, nil, nil, owner.Address())
+
+	user1 := m.NewWallet()
+	user1.AddBalance("cc", 1000)
+	hashed := sha3.Sum256([]byte("123"))
+	swapHash := hex.EncodeToString(hashed[:])
+
+	swapExists := func(id string) bool {
+		key, err := m.GetStub("vt").CreateCompositeKey("swaps", []string{id})
+		assert.NoError(t, err)
+		_, exists := m.GetStub("vt").State[key]
+		return exists
+	}
+
+	_, err := owner.SignedNbInvoke("vt", "pause", "", "false", "false", "incident")
+	assert.NoError(t, err)
+	txID := user1.SignedInvoke("cc", "swapBegin", "CC", "VT", "100", swapHash)
+	assert.True(t, swapExists(txID))
+	err = user1.InvokeWithError("vt", "swapDone", txID, "123")
+	assert.ErrorContains(t, err, core.ErrPaused.Error())
+
+	_, err = owner.SignedNbInvoke("vt", "pause", "", "true", "false", "incident")
+	assert.NoError(t, err)
+	blockedTxID := user1.SignedInvoke("cc", "swapBegin", "CC", "VT", "100", swapHash)
+	assert.False(t, swapExists(blockedTxID))
+	user1.BalanceShouldBe("cc", 800)
+
+	_, err = owner.SignedNbInvoke("vt", "unpause", "resolved")
+	assert.NoError(t, err)
+	user1.Invoke("vt", "swapDone", txID, "123")
+	user1.AllowedBalanceShouldBe("vt", "CC", 100)
+}
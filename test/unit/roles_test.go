@@ -0,0 +1,107 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRoleRotation - Checking that the issuer key is rotated in two steps without re-initializing the chaincode
+func TestRoleRotation(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	feeSetter := ledgerMock.NewWallet()
+	newOwner := ledgerMock.NewWallet()
+	user := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address(), feeSetter.Address())
+
+	var roles []*core.RoleHolder
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke(testTokenCCName, "roles")), &roles))
+	assert.Equal(t, []*core.RoleHolder{
+		{Role: core.RoleAdmin, Address: owner.Address()},
+		{Role: core.RoleFeeSetter, Address: feeSetter.Address()},
+		{Role: core.RoleFeeAddressSetter},
+	}, roles)
+
+	err := user.RawSignedInvokeWithErrorReturned(testTokenCCName, "proposeRole", core.RoleAdmin, user.Address())
+	assert.EqualError(t, err, core.ErrRoleAdminOnly.Error())
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "proposeRole", "auditor", newOwner.Address())
+	assert.ErrorContains(t, err, core.ErrRoleUnknown.Error())
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "proposeRole", core.RoleAdmin, owner.Address())
+	assert.EqualError(t, err, core.ErrRoleAlreadyHolder.Error())
+
+	// the new proposal replaces the previous one, the cancelled proposal can't be accepted
+	owner.SignedInvoke(testTokenCCName, "proposeRole", core.RoleAdmin, user.Address())
+	owner.SignedInvoke(testTokenCCName, "proposeRole", core.RoleAdmin, newOwner.Address())
+	err = user.RawSignedInvokeWithErrorReturned(testTokenCCName, "acceptRole", core.RoleAdmin)
+	assert.EqualError(t, err, core.ErrRoleNotProposed.Error())
+
+	err = newOwner.RawSignedInvokeWithErrorReturned(testTokenCCName, "cancelRoleProposal", core.RoleAdmin)
+	assert.EqualError(t, err, core.ErrRoleAdminOnly.Error())
+	owner.SignedInvoke(testTokenCCName, "cancelRoleProposal", core.RoleAdmin)
+	err = newOwner.RawSignedInvokeWithErrorReturned(testTokenCCName, "acceptRole", core.RoleAdmin)
+	assert.EqualError(t, err, core.ErrRoleNotProposed.Error())
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "cancelRoleProposal", core.RoleAdmin)
+	assert.EqualError(t, err, core.ErrRoleNoProposal.Error())
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke(testTokenCCName, "roles")), &roles))
+	assert.Equal(t, &core.RoleHolder{Role: core.RoleAdmin, Address: owner.Address()}, roles[0])
+
+	owner.SignedInvoke(testTokenCCName, "proposeRole", core.RoleAdmin, newOwner.Address())
+
+	// the role passes only after it's accepted
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user.Address(), "100")
+	newOwner.SignedInvoke(testTokenCCName, "acceptRole", core.RoleAdmin)
+
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "emissionAdd", user.Address(), "100")
+	assert.EqualError(t, err, "unauthorized")
+	newOwner.SignedInvoke(testTokenCCName, "emissionAdd", user.Address(), "100")
+	user.BalanceShouldBe(testTokenCCName, 200)
+
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "proposeRole", core.RoleFeeSetter, user.Address())
+	assert.EqualError(t, err, core.ErrRoleAdminOnly.Error())
+	newOwner.SignedInvoke(testTokenCCName, "proposeRole", core.RoleFeeSetter, user.Address())
+
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke(testTokenCCName, "roles")), &roles))
+	assert.Equal(t, newOwner.Address(), roles[0].Address)
+	assert.Equal(t, feeSetter.Address(), roles[1].Address)
+	assert.Equal(t, user.Address(), roles[1].Proposed)
+}
+
+// TestRoleNotSet - Checking that methods of the role which isn't set reject callers instead of panicking
+// and that the registry keeps the holder from the init args after the first use
+func TestRoleNotSet(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+
+	err := user.RawSignedInvokeWithErrorReturned(testTokenCCName, "setFee", testTokenSymbol, "500000", "1", "0")
+	assert.EqualError(t, err, "unauthorized")
+	err = user.RawSignedInvokeWithErrorReturned(testTokenCCName, "setFeeAddress", user.Address())
+	assert.EqualError(t, err, "unauthorized")
+
+	owner.SignedInvoke(testTokenCCName, "setMaxSupply", "1000")
+	key, err := ledgerMock.GetStub(testTokenCCName).CreateCompositeKey("roleHolder", []string{core.RoleAdmin})
+	assert.NoError(t, err)
+	assert.Equal(t, owner.AddressType().Bytes(), ledgerMock.GetStub(testTokenCCName).State[key])
+}
@@ -0,0 +1,73 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBalanceSnapshots - Checking that balances and total emission are kept as of the moment snapshots were opened
+func TestBalanceSnapshots(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+	user2 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+	owner.SignedInvoke(testTokenCCName, "industrialBalanceAdd", testTokenWithGroup, user1.Address(), "500", "add")
+
+	err := owner.InvokeWithError(testTokenCCName, "balanceAtSnapshot", user1.Address(), "1")
+	assert.EqualError(t, err, core.ErrSnapshotNotFound.Error())
+
+	err = user1.RawSignedInvokeWithErrorReturned(testTokenCCName, "openSnapshot")
+	assert.EqualError(t, err, core.ErrSnapshotAdminOnly.Error())
+
+	owner.SignedInvoke(testTokenCCName, "openSnapshot")
+	user1.SignedInvoke(testTokenCCName, "transfer", user2.Address(), "100", "")
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user2.Address(), "50")
+	owner.SignedInvoke(testTokenCCName, "industrialBalanceTransfer", testTokenWithGroup, user1.Address(), user2.Address(), "200", "transfer")
+
+	owner.SignedInvoke(testTokenCCName, "openSnapshot")
+	user1.SignedInvoke(testTokenCCName, "transfer", user2.Address(), "100", "")
+	user1.SignedInvoke(testTokenCCName, "transfer", user2.Address(), "100", "")
+
+	snapshot := &core.Snapshot{}
+	assert.NoError(t, json.Unmarshal([]byte(owner.Invoke(testTokenCCName, "snapshot", "0")), snapshot))
+	assert.Equal(t, uint64(2), snapshot.ID)
+	assert.NotEmpty(t, snapshot.TxID)
+
+	for _, tc := range []struct {
+		fn       string
+		args     []string
+		expected string
+	}{
+		{"balanceAtSnapshot", []string{user1.Address(), "1"}, "1000"},
+		{"balanceAtSnapshot", []string{user1.Address(), "2"}, "900"},
+		{"balanceOf", []string{user1.Address()}, "700"},
+		{"balanceAtSnapshot", []string{user2.Address(), "1"}, "0"},
+		{"balanceAtSnapshot", []string{user2.Address(), "2"}, "150"},
+		{"industrialBalanceAtSnapshot", []string{user1.Address(), testTokenWithGroup, "1"}, "500"},
+		{"industrialBalanceAtSnapshot", []string{user1.Address(), testTokenWithGroup, "2"}, "300"},
+		{"industrialBalanceAtSnapshot", []string{user2.Address(), testTokenWithGroup, "1"}, "0"},
+		{"totalSupplyAtSnapshot", []string{"1"}, "1000"},
+		{"totalSupplyAtSnapshot", []string{"2"}, "1050"},
+	} {
+		assert.Equal(t, "\""+tc.expected+"\"", owner.Invoke(testTokenCCName, tc.fn, tc.args...), tc.fn, tc.args)
+	}
+
+	err = owner.InvokeWithError(testTokenCCName, "balanceAtSnapshot", user1.Address(), "3")
+	assert.EqualError(t, err, core.ErrSnapshotNotFound.Error())
+}
@@ -0,0 +1,118 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func reconcileSupply(t *testing.T, w *mock.Wallet, pageSize string) *token.SupplyReconciliation {
+	var (
+		result   = &token.SupplyReconciliation{}
+		bookmark string
+	)
+	for {
+		assert.NoError(t, json.Unmarshal([]byte(w.Invoke(testTokenCCName, "reconcileSupply", pageSize, bookmark)), result))
+		if result.Done {
+			return result
+		}
+		assert.NotEmpty(t, result.Bookmark)
+		bookmark = result.Bookmark
+	}
+}
+
+// TestHoldersAndSupplyReconciliation - Checking holders enumeration and reconciliation of balances with the total emission
+func TestHoldersAndSupplyReconciliation(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+	user2 := ledgerMock.NewWallet()
+	user3 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user2.Address(), "500")
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user3.Address(), "300")
+	owner.SignedInvoke(testTokenCCName, "industrialBalanceAdd", testTokenWithGroup, user1.Address(), "700", "add")
+
+	// user2 has locked balance only, user1 has both
+	for _, lock := range []*proto.BalanceLockRequest{
+		{Address: user1.Address(), Token: testTokenCCName, Amount: "100", Reason: "test"},
+		{Address: user2.Address(), Token: testTokenCCName, Amount: "500", Reason: "test"},
+	} {
+		data, err := json.Marshal(lock)
+		assert.NoError(t, err)
+		owner.SignedInvoke(testTokenCCName, "lockTokenBalance", string(data))
+	}
+
+	t.Run("holders pages", func(t *testing.T) {
+		holders := make(map[string]*core.TokenHolder)
+		var bookmark string
+		for {
+			page := &token.Holders{}
+			assert.NoError(t, json.Unmarshal([]byte(owner.Invoke(testTokenCCName, "holders", "1", bookmark)), page))
+			for _, holder := range page.Holders {
+				assert.NotContains(t, holders, holder.Address)
+				holders[holder.Address] = holder
+			}
+			if page.Bookmark == "" {
+				break
+			}
+			bookmark = page.Bookmark
+		}
+
+		assert.Len(t, holders, 3)
+		assert.Equal(t, "900", holders[user1.Address()].Balance.String())
+		assert.Equal(t, "100", holders[user1.Address()].Locked.String())
+		assert.Equal(t, "0", holders[user2.Address()].Balance.String())
+		assert.Equal(t, "500", holders[user2.Address()].Locked.String())
+		assert.Equal(t, "300", holders[user3.Address()].Balance.String())
+		assert.Equal(t, "0", holders[user3.Address()].Locked.String())
+	})
+
+	t.Run("reconciliation without drift", func(t *testing.T) {
+		for _, pageSize := range []string{"1", "2", "1000"} {
+			result := reconcileSupply(t, owner, pageSize)
+			assert.Equal(t, "1800", result.TotalEmission.String())
+			assert.Equal(t, "1200", result.Balance.String())
+			assert.Equal(t, "600", result.Locked.String())
+			assert.Equal(t, "0", result.Given.String())
+			assert.Equal(t, uint64(3), result.Holders)
+			assert.Equal(t, "0", result.Drift.String())
+		}
+	})
+
+	t.Run("reconciliation with drift", func(t *testing.T) {
+		user3.AddBalance(testTokenCCName, 50)
+		owner.AddGivenBalance(testTokenCCName, "VT", 20)
+
+		result := reconcileSupply(t, owner, "2")
+		assert.Equal(t, "1250", result.Balance.String())
+		assert.Equal(t, "20", result.Given.String())
+		assert.Equal(t, "-70", result.Drift.String())
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		err := owner.InvokeWithError(testTokenCCName, "holders", "0", "")
+		assert.EqualError(t, err, token.ErrHoldersPageSize.Error())
+
+		err = owner.InvokeWithError(testTokenCCName, "holders", "10", "wrong")
+		assert.ErrorContains(t, err, core.ErrHoldersBookmark.Error())
+
+		err = owner.InvokeWithError(testTokenCCName, "reconcileSupply", "10", "wrong")
+		assert.ErrorContains(t, err, token.ErrReconciliationBookmark.Error())
+	})
+}
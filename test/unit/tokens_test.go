@@ -9,6 +9,7 @@ import (
 	"github.com/atomyze-foundation/foundation/core/types/big"
 	"github.com/atomyze-foundation/foundation/mock"
 	"github.com/atomyze-foundation/foundation/token"
+	"github.com/btcsuite/btcutil/base58"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -157,6 +158,37 @@ func TestMultisigEmitTransfer(t *testing.T) {
 	user1.BalanceShouldBe("fiat", 1000)
 }
 
+// TestWeightedMultisigEmit - Checking "CFO + any two of five" signature policy
+func TestWeightedMultisigEmit(t *testing.T) {
+	m := mock.NewLedger(t)
+	owner := m.NewMultisigWallet(6)
+	// key 0 is the CFO, it must always sign, so 3 signatures are required: CFO and any two of five
+	owner.SetSignaturePolicy(3, nil, 0)
+	fiat := NewFiatTestToken(token.BaseToken{
+		Name:   "fiat token",
+		Symbol: "FIAT",
+	})
+	m.NewChainCode("fiat", fiat, nil, nil, owner.Address())
+
+	user1 := m.NewWallet()
+
+	_, res, _ := owner.RawSignedInvokeBy([]int{0, 2, 5}, "fiat", "emit", user1.Address(), "1000")
+	assert.Equal(t, "", res.Error)
+	user1.BalanceShouldBe("fiat", 1000)
+
+	err := owner.RawSignedInvokeByWithErrorReturned([]int{0, 3}, "fiat", "emit", user1.Address(), "1000")
+	assert.EqualError(t, err, "signature policy isn't satisfied")
+
+	err = owner.RawSignedInvokeByWithErrorReturned([]int{1, 2, 3, 4}, "fiat", "emit", user1.Address(), "1000")
+	assert.ErrorContains(t, err, "required signer "+base58.Encode(owner.PubKeys()[0])+" hasn't signed")
+
+	// CFO's key weighs as much as two other keys
+	owner.SetSignaturePolicy(3, []uint32{2}, 0)
+	_, res, _ = owner.RawSignedInvokeBy([]int{0, 4}, "fiat", "emit", user1.Address(), "1000")
+	assert.Equal(t, "", res.Error)
+	user1.BalanceShouldBe("fiat", 2000)
+}
+
 func TestBuyLimit(t *testing.T) {
 	m := mock.NewLedger(t)
 	owner := m.NewWallet()
@@ -0,0 +1,71 @@
+package unit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/token"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVelocityLimits - Checking that transfers are limited within rolling windows and the limits free up as time goes
+func TestVelocityLimits(t *testing.T) {
+	ledgerMock := mock.NewLedger(t)
+	owner := ledgerMock.NewWallet()
+	user1 := ledgerMock.NewWallet()
+	user2 := ledgerMock.NewWallet()
+
+	tt := &TestToken{
+		token.BaseToken{
+			Name:     testTokenName,
+			Symbol:   testTokenSymbol,
+			Decimals: 8,
+		},
+	}
+	ledgerMock.NewChainCode(testTokenCCName, tt, &core.ContractOptions{}, nil, owner.Address())
+
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user1.Address(), "1000")
+	owner.SignedInvoke(testTokenCCName, "emissionAdd", user2.Address(), "1000")
+
+	err := user1.RawSignedInvokeWithErrorReturned(testTokenCCName, "setVelocityLimit", testTokenSymbol, core.VelocityDay, "100", "0")
+	assert.EqualError(t, err, core.ErrVelocityAdminOnly.Error())
+	err = owner.RawSignedInvokeWithErrorReturned(testTokenCCName, "setVelocityLimit", testTokenSymbol, "week", "100", "0")
+	assert.EqualError(t, err, core.ErrVelocityWindow.Error())
+
+	now := ledgerMock.GetStub(testTokenCCName).TxTimestamp.Seconds
+	ledgerMock.GetStub(testTokenCCName).SetTxTimestamp(&timestamp.Timestamp{Seconds: now})
+	defer ledgerMock.GetStub(testTokenCCName).SetTxTimestamp(nil)
+
+	owner.SignedInvoke(testTokenCCName, "setVelocityLimit", testTokenSymbol, core.VelocityDay, "100", "0")
+	owner.SignedInvoke(testTokenCCName, "setVelocityLimit", testTokenSymbol, core.VelocityMonth, "0", "3")
+	owner.SignedInvoke(testTokenCCName, "setAddressVelocityLimit", user2.Address(), testTokenSymbol, core.VelocityDay, "500", "0")
+
+	user1.SignedInvoke(testTokenCCName, "transfer", owner.Address(), "60", "")
+	err = user1.RawSignedInvokeWithErrorReturned(testTokenCCName, "transfer", owner.Address(), "50", "")
+	assert.ErrorContains(t, err, core.ErrVelocityLimit.Error())
+	user1.SignedInvoke(testTokenCCName, "transfer", owner.Address(), "40", "")
+	user2.SignedInvoke(testTokenCCName, "transfer", owner.Address(), "300", "")
+
+	var headroom []*core.VelocityHeadroom
+	assert.NoError(t, json.Unmarshal([]byte(user1.Invoke(testTokenCCName, "velocityHeadroom", user1.Address(), testTokenSymbol)), &headroom))
+	assert.Len(t, headroom, 2)
+	assert.Equal(t, "100", headroom[0].Amount)
+	assert.Equal(t, "0", headroom[0].RemainingAmount)
+	assert.Equal(t, uint64(2), headroom[1].Count)
+	assert.Equal(t, uint64(1), headroom[1].RemainingCount)
+
+	// the day window moved, the month window still counts the transfers
+	ledgerMock.GetStub(testTokenCCName).SetTxTimestamp(&timestamp.Timestamp{Seconds: now + 25*60*60})
+	user1.SignedInvoke(testTokenCCName, "transfer", owner.Address(), "100", "")
+	err = user1.RawSignedInvokeWithErrorReturned(testTokenCCName, "transfer", owner.Address(), "1", "")
+	assert.ErrorContains(t, err, core.ErrVelocityLimit.Error())
+
+	ledgerMock.GetStub(testTokenCCName).SetTxTimestamp(&timestamp.Timestamp{Seconds: now + 31*24*60*60})
+	user1.SignedInvoke(testTokenCCName, "transfer", owner.Address(), "100", "")
+
+	user1.BalanceShouldBe(testTokenCCName, 700)
+	user2.BalanceShouldBe(testTokenCCName, 700)
+}
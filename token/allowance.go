@@ -0,0 +1,74 @@
+package token
+
+import (
+	"errors"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+)
+
+// allowance errors
+var (
+	ErrAllowanceSelf     = errors.New("owner can't be the spender")
+	ErrAllowanceAmount   = errors.New("amount should be more than zero")
+	ErrAllowanceNegative = errors.New("amount should be positive")
+)
+
+// TxApprove sets the amount of tokens the spender can transfer from the sender's balance with TxTransferFrom.
+// The previous allowance is replaced, zero amount revokes it
+func (bt *BaseToken) TxApprove(sender *types.Sender, spender *types.Address, amount *big.Int) error {
+	if sender.Equal(spender) {
+		return ErrAllowanceSelf
+	}
+	if amount.Sign() < 0 {
+		return ErrAllowanceNegative
+	}
+	return bt.TokenAllowanceSet(sender.Address(), spender, amount)
+}
+
+// TxIncreaseAllowance increases the amount of tokens the spender can transfer from the sender's balance
+func (bt *BaseToken) TxIncreaseAllowance(sender *types.Sender, spender *types.Address, amount *big.Int) error {
+	if sender.Equal(spender) {
+		return ErrAllowanceSelf
+	}
+	if amount.Sign() <= 0 {
+		return ErrAllowanceAmount
+	}
+	return bt.TokenAllowanceAdd(sender.Address(), spender, amount)
+}
+
+// TxDecreaseAllowance decreases the amount of tokens the spender can transfer from the sender's balance.
+// The allowance can't be decreased below zero
+func (bt *BaseToken) TxDecreaseAllowance(sender *types.Sender, spender *types.Address, amount *big.Int) error {
+	if sender.Equal(spender) {
+		return ErrAllowanceSelf
+	}
+	if amount.Sign() <= 0 {
+		return ErrAllowanceAmount
+	}
+	return bt.TokenAllowanceSub(sender.Address(), spender, amount)
+}
+
+// TxTransferFrom transfers tokens from the owner's balance within the allowance given to the sender.
+// The transfer fee is charged from the owner like in TxTransfer and doesn't decrease the allowance
+func (bt *BaseToken) TxTransferFrom(sender *types.Sender, from *types.Address, to *types.Address, amount *big.Int) error {
+	if sender.Equal(from) {
+		return ErrAllowanceSelf
+	}
+	if from.Equal(to) {
+		return errors.New("impossible operation")
+	}
+	if amount.Sign() <= 0 {
+		return ErrAllowanceAmount
+	}
+
+	if err := bt.TokenAllowanceSub(from, sender.Address(), amount); err != nil {
+		return err
+	}
+	return bt.transfer(from, to, amount, "transferFrom")
+}
+
+// QueryAllowance returns the amount of tokens the spender can transfer from the owner's balance
+func (bt *BaseToken) QueryAllowance(owner *types.Address, spender *types.Address) (*big.Int, error) {
+	return bt.TokenAllowanceGet(owner, spender)
+}
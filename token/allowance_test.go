@@ -0,0 +1,62 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	ma "github.com/atomyze-foundation/foundation/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowanceTransferFrom(t *testing.T) {
+	mock := ma.NewLedger(t)
+	issuer := mock.NewWallet()
+	feeAddressSetter := mock.NewWallet()
+	feeSetter := mock.NewWallet()
+	feeAggregator := mock.NewWallet()
+	marketplace := mock.NewWallet()
+	seller := mock.NewWallet()
+
+	vt := &VT{
+		BaseToken{
+			Name:     vtName,
+			Symbol:   "VT",
+			Decimals: 8,
+		},
+	}
+
+	mock.NewChainCode("vt", vt, &core.ContractOptions{}, nil, issuer.Address(), feeSetter.Address(), feeAddressSetter.Address())
+
+	issuer.SignedInvoke("vt", "emitToken", "1000")
+	feeSetter.SignedInvoke("vt", "setFee", "VT", "500000", "1", "0")
+	feeAddressSetter.SignedInvoke("vt", "setFeeAddress", feeAggregator.Address())
+
+	err := issuer.RawSignedInvokeWithErrorReturned("vt", "approve", issuer.Address(), "100")
+	assert.EqualError(t, err, ErrAllowanceSelf.Error())
+
+	issuer.SignedInvoke("vt", "approve", marketplace.Address(), "100")
+	issuer.SignedInvoke("vt", "increaseAllowance", marketplace.Address(), "150")
+	issuer.SignedInvoke("vt", "decreaseAllowance", marketplace.Address(), "50")
+	assert.Equal(t, "\"200\"", issuer.Invoke("vt", "allowance", issuer.Address(), marketplace.Address()))
+
+	err = issuer.RawSignedInvokeWithErrorReturned("vt", "decreaseAllowance", marketplace.Address(), "201")
+	assert.EqualError(t, err, core.ErrInsufficientAllowance.Error())
+
+	err = marketplace.RawSignedInvokeWithErrorReturned("vt", "transferFrom", issuer.Address(), seller.Address(), "201")
+	assert.EqualError(t, err, core.ErrInsufficientAllowance.Error())
+
+	marketplace.SignedInvoke("vt", "transferFrom", issuer.Address(), seller.Address(), "150")
+	issuer.BalanceShouldBe("vt", 849)
+	seller.BalanceShouldBe("vt", 150)
+	feeAggregator.BalanceShouldBe("vt", 1)
+	assert.Equal(t, "\"50\"", issuer.Invoke("vt", "allowance", issuer.Address(), marketplace.Address()))
+
+	// the allowance isn't spent if the transfer fails
+	issuer.SignedInvoke("vt", "transfer", seller.Address(), "840", "")
+	err = marketplace.RawSignedInvokeWithErrorReturned("vt", "transferFrom", issuer.Address(), seller.Address(), "50")
+	assert.EqualError(t, err, "insufficient funds to process")
+	assert.Equal(t, "\"50\"", issuer.Invoke("vt", "allowance", issuer.Address(), marketplace.Address()))
+
+	issuer.SignedInvoke("vt", "approve", marketplace.Address(), "0")
+	assert.Equal(t, "\"0\"", issuer.Invoke("vt", "allowance", issuer.Address(), marketplace.Address()))
+}
@@ -7,24 +7,22 @@ import (
 	"github.com/atomyze-foundation/foundation/core/types/big"
 )
 
-// CheckLimitsAndPrice checks limits and price
+// CheckLimitsAndPrice checks the validity of the rate, limits and price
 func (bt *BaseToken) CheckLimitsAndPrice(method string, amount *big.Int, currency string) (*big.Int, error) {
 	rate, exists, err := bt.GetRateAndLimits(method, currency)
 	if err != nil {
 		return big.NewInt(0), err
 	}
-	if !exists {
-		return big.NewInt(0), errors.New("impossible to buy for this currency")
-	}
-	if !rate.InLimit(amount) {
-		return big.NewInt(0), errors.New("amount out of limits")
-	}
-	return rate.CalcPrice(amount, RateDecimal), nil
+	return priceOf(bt.GetStub(), rate, exists, amount)
 }
 
 // TxBuyToken buys tokens for an asset
 func (bt *BaseToken) TxBuyToken(sender *types.Sender, amount *big.Int, currency string) error {
-	if sender.Equal(bt.Issuer()) {
+	issuer, err := bt.GetIssuer()
+	if err != nil {
+		return err
+	}
+	if sender.Equal(issuer) {
 		return errors.New("impossible operation")
 	}
 
@@ -37,16 +35,24 @@ func (bt *BaseToken) TxBuyToken(sender *types.Sender, amount *big.Int, currency
 		return err
 	}
 
-	if err = bt.AllowedBalanceTransfer(currency, sender.Address(), bt.Issuer(), price, "buyToken"); err != nil {
+	if err = bt.AllowedBalanceTransfer(currency, sender.Address(), issuer, price, "buyToken"); err != nil {
+		return err
+	}
+
+	if err = bt.TokenBalanceTransfer(issuer, sender.Address(), amount, "buyToken"); err != nil {
 		return err
 	}
 
-	return bt.TokenBalanceTransfer(bt.Issuer(), sender.Address(), amount, "buyToken")
+	return bt.chargeFee(FeeOperationBuyToken, sender.Address(), issuer, amount, "buyToken")
 }
 
 // TxBuyBack buys back tokens for an asset
 func (bt *BaseToken) TxBuyBack(sender *types.Sender, amount *big.Int, currency string) error {
-	if sender.Equal(bt.Issuer()) {
+	issuer, err := bt.GetIssuer()
+	if err != nil {
+		return err
+	}
+	if sender.Equal(issuer) {
 		return errors.New("impossible operation")
 	}
 
@@ -59,9 +65,13 @@ func (bt *BaseToken) TxBuyBack(sender *types.Sender, amount *big.Int, currency s
 		return err
 	}
 
-	if err = bt.AllowedBalanceTransfer(currency, bt.Issuer(), sender.Address(), price, "buyBack"); err != nil {
+	if err = bt.AllowedBalanceTransfer(currency, issuer, sender.Address(), price, "buyBack"); err != nil {
+		return err
+	}
+
+	if err = bt.TokenBalanceTransfer(sender.Address(), issuer, amount, "buyBack"); err != nil {
 		return err
 	}
 
-	return bt.TokenBalanceTransfer(sender.Address(), bt.Issuer(), amount, "buyBack")
+	return bt.chargeFee(FeeOperationBuyBack, sender.Address(), issuer, amount, "buyBack")
 }
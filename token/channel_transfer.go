@@ -0,0 +1,50 @@
+package token
+
+import (
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+)
+
+// TxChannelTransferByCustomer - transaction initiating transfer between channels, see core.BaseContract.
+// The fee of the channel transfer of tokens of the contract is charged from the owner of tokens
+func (bt *BaseToken) TxChannelTransferByCustomer(
+	sender *types.Sender,
+	idTransfer string,
+	to string,
+	token string,
+	amount *big.Int,
+) (string, error) {
+	id, err := bt.BaseContract.TxChannelTransferByCustomer(sender, idTransfer, to, token, amount)
+	if err != nil {
+		return "", err
+	}
+	return id, bt.chargeChannelTransferFee(sender.Address(), token, amount)
+}
+
+// TxChannelTransferByAdmin - transaction initiating transfer between channels, see core.BaseContract.
+// The fee of the channel transfer of tokens of the contract is charged from the owner of tokens
+func (bt *BaseToken) TxChannelTransferByAdmin(
+	sender *types.Sender,
+	idTransfer string,
+	to string,
+	idUser *types.Address,
+	token string,
+	amount *big.Int,
+) (string, error) {
+	id, err := bt.BaseContract.TxChannelTransferByAdmin(sender, idTransfer, to, idUser, token, amount)
+	if err != nil {
+		return "", err
+	}
+	return id, bt.chargeChannelTransferFee(idUser, token, amount)
+}
+
+// chargeChannelTransferFee charges the fee of the channel transfer only if tokens of the contract are transferred,
+// the amount of tokens of other channels isn't in units of the fee schedule
+func (bt *BaseToken) chargeChannelTransferFee(payer *types.Address, token string, amount *big.Int) error {
+	if !strings.EqualFold(strings.Split(token, "_")[0], bt.Symbol) {
+		return nil
+	}
+	return bt.chargeFee(FeeOperationChannelTransfer, payer, nil, amount, "ch-transfer")
+}
@@ -0,0 +1,105 @@
+package token
+
+import (
+	"errors"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+)
+
+// emission errors
+var (
+	ErrEmissionIssuerOnly     = errors.New("only the issuer can emit and burn tokens")
+	ErrEmissionAmount         = errors.New("amount should be more than zero")
+	ErrMaxSupplyExceeded      = errors.New("emission exceeds max supply")
+	ErrMaxSupplyBelowEmission = errors.New("max supply is less than total emission")
+)
+
+// MintableSupply is the max supply of the token and the amount which can still be emitted,
+// Mintable is nil if the supply isn't capped
+type MintableSupply struct {
+	MaxSupply     *big.Int `json:"max_supply"`     //nolint:tagliatelle
+	TotalEmission *big.Int `json:"total_emission"` //nolint:tagliatelle
+	Mintable      *big.Int `json:"mintable"`
+}
+
+func (bt *BaseToken) checkEmissionArgs(sender *types.Sender, amount *big.Int) error {
+	if err := checkRoleHolder(sender, bt.GetIssuer, ErrEmissionIssuerOnly); err != nil {
+		return err
+	}
+	if amount.Sign() == 0 {
+		return ErrEmissionAmount
+	}
+	return bt.loadConfigUnlessLoaded()
+}
+
+// TxSetMaxSupply sets the cap of the total emission, zero removes the cap
+func (bt *BaseToken) TxSetMaxSupply(sender *types.Sender, maxSupply *big.Int) error {
+	if err := checkRoleHolder(sender, bt.GetIssuer, ErrEmissionIssuerOnly); err != nil {
+		return err
+	}
+	if err := bt.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	if maxSupply.Sign() != 0 && maxSupply.Cmp(new(big.Int).SetBytes(bt.config.TotalEmission)) < 0 {
+		return ErrMaxSupplyBelowEmission
+	}
+	bt.config.MaxSupply = maxSupply.Bytes()
+	return bt.saveConfig()
+}
+
+// TxEmit emits tokens to the address, the total emission can't exceed the max supply
+func (bt *BaseToken) TxEmit(sender *types.Sender, to *types.Address, amount *big.Int) error {
+	if err := bt.checkEmissionArgs(sender, amount); err != nil {
+		return err
+	}
+	maxSupply := new(big.Int).SetBytes(bt.config.MaxSupply)
+	total := new(big.Int).Add(new(big.Int).SetBytes(bt.config.TotalEmission), amount)
+	if maxSupply.Sign() != 0 && total.Cmp(maxSupply) > 0 {
+		return ErrMaxSupplyExceeded
+	}
+	if err := bt.TokenBalanceAdd(to, amount, "emit"); err != nil {
+		return err
+	}
+	return bt.EmissionAdd(amount)
+}
+
+// TxBurn burns tokens of the issuer
+func (bt *BaseToken) TxBurn(sender *types.Sender, amount *big.Int) error {
+	if err := bt.checkEmissionArgs(sender, amount); err != nil {
+		return err
+	}
+	if err := bt.TokenBalanceSub(sender.Address(), amount, "burn"); err != nil {
+		return err
+	}
+	return bt.EmissionSub(amount)
+}
+
+// TxRedeem burns tokens of the holder, e.g. when the underlying asset is paid out to the holder
+func (bt *BaseToken) TxRedeem(sender *types.Sender, from *types.Address, amount *big.Int) error {
+	if err := bt.checkEmissionArgs(sender, amount); err != nil {
+		return err
+	}
+	if err := bt.TokenBalanceSub(from, amount, "redeem"); err != nil {
+		return err
+	}
+	return bt.EmissionSub(amount)
+}
+
+// QueryMintableSupply returns the max supply and the amount of tokens which can still be emitted
+func (bt *BaseToken) QueryMintableSupply() (*MintableSupply, error) {
+	if err := bt.loadConfigUnlessLoaded(); err != nil {
+		return nil, err
+	}
+	supply := &MintableSupply{
+		MaxSupply:     new(big.Int).SetBytes(bt.config.MaxSupply),
+		TotalEmission: new(big.Int).SetBytes(bt.config.TotalEmission),
+	}
+	if supply.MaxSupply.Sign() != 0 {
+		supply.Mintable = new(big.Int).Sub(supply.MaxSupply, supply.TotalEmission)
+		if supply.Mintable.Sign() < 0 {
+			supply.Mintable = new(big.Int)
+		}
+	}
+	return supply, nil
+}
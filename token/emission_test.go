@@ -0,0 +1,60 @@
+package token
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	ma "github.com/atomyze-foundation/foundation/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmitBurnRedeemWithMaxSupply(t *testing.T) {
+	mock := ma.NewLedger(t)
+	issuer := mock.NewWallet()
+	feeAddressSetter := mock.NewWallet()
+	feeSetter := mock.NewWallet()
+	user := mock.NewWallet()
+
+	vt := &VT{
+		BaseToken{
+			Name:     vtName,
+			Symbol:   "VT",
+			Decimals: 8,
+		},
+	}
+
+	mock.NewChainCode("vt", vt, &core.ContractOptions{}, nil, issuer.Address(), feeSetter.Address(), feeAddressSetter.Address())
+
+	err := user.RawSignedInvokeWithErrorReturned("vt", "emit", user.Address(), "100")
+	assert.EqualError(t, err, ErrEmissionIssuerOnly.Error())
+	err = issuer.RawSignedInvokeWithErrorReturned("vt", "emit", user.Address(), "0")
+	assert.EqualError(t, err, ErrEmissionAmount.Error())
+
+	issuer.SignedInvoke("vt", "emit", user.Address(), "600")
+	issuer.SignedInvoke("vt", "emit", issuer.Address(), "200")
+
+	err = issuer.RawSignedInvokeWithErrorReturned("vt", "setMaxSupply", "700")
+	assert.EqualError(t, err, ErrMaxSupplyBelowEmission.Error())
+	issuer.SignedInvoke("vt", "setMaxSupply", "1000")
+
+	err = issuer.RawSignedInvokeWithErrorReturned("vt", "emit", user.Address(), "201")
+	assert.EqualError(t, err, ErrMaxSupplyExceeded.Error())
+
+	issuer.SignedInvoke("vt", "burn", "50")
+	issuer.SignedInvoke("vt", "redeem", user.Address(), "100")
+	user.BalanceShouldBe("vt", 500)
+	issuer.BalanceShouldBe("vt", 150)
+
+	supply := &MintableSupply{}
+	assert.NoError(t, json.Unmarshal([]byte(issuer.Invoke("vt", "mintableSupply")), supply))
+	assert.Equal(t, "1000", supply.MaxSupply.String())
+	assert.Equal(t, "650", supply.TotalEmission.String())
+	assert.Equal(t, "350", supply.Mintable.String())
+
+	issuer.SignedInvoke("vt", "setMaxSupply", "0")
+	issuer.SignedInvoke("vt", "emit", user.Address(), "1000")
+	assert.NoError(t, json.Unmarshal([]byte(issuer.Invoke("vt", "mintableSupply")), supply))
+	assert.Nil(t, supply.Mintable)
+	assert.Equal(t, "1650", supply.TotalEmission.String())
+}
@@ -0,0 +1,174 @@
+package token
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+// kinds of fee exemptions
+const (
+	FeeExemptionAddress = "address"
+	FeeExemptionUserID  = "userID"
+)
+
+// fee exemption modes
+const (
+	FeeExemptSender   = "sender"
+	FeeExemptReceiver = "receiver"
+	FeeExemptBoth     = "both"
+	FeeExemptNone     = "none"
+)
+
+const feeExemptionKey = "feeExemption"
+
+// fee exemption errors
+var (
+	ErrFeeExemptionUnauthorized = errors.New("fee exemptions are managed by the issuer or the fee setter")
+	ErrFeeExemptionKind         = errors.New("fee exemption kind must be address or userID")
+	ErrFeeExemptionMode         = errors.New("fee exemption mode must be sender, receiver, both or none")
+)
+
+// FeeExemption exempts the address or all addresses of the user from fees as senders, receivers or both
+type FeeExemption struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+	Mode string `json:"mode"`
+}
+
+// TxSetFeeExemption sets the fee exemption of the address or of the user ID from the ACL,
+// mode none deletes the exemption
+func (bt *BaseToken) TxSetFeeExemption(sender *types.Sender, kind string, id string, mode string) error {
+	if err := checkFeeExemptionManager(sender, bt.GetIssuer, bt.GetFeeSetter); err != nil {
+		return err
+	}
+	return setFeeExemption(bt.GetStub(), kind, id, mode)
+}
+
+// checkFeeExemptionManager checks that the sender is the issuer or the fee setter
+func checkFeeExemptionManager(sender *types.Sender, getIssuer, getFeeSetter func() (*types.Address, error)) error {
+	if err := checkRoleHolder(sender, getIssuer, ErrFeeExemptionUnauthorized); err != nil {
+		if !errors.Is(err, ErrFeeExemptionUnauthorized) {
+			return err
+		}
+		return checkRoleHolder(sender, getFeeSetter, ErrFeeExemptionUnauthorized)
+	}
+	return nil
+}
+
+func setFeeExemption(stub shim.ChaincodeStubInterface, kind string, id string, mode string) error {
+	switch kind {
+	case FeeExemptionAddress:
+		addr, err := types.AddrFromBase58Check(id)
+		if err != nil {
+			return err
+		}
+		id = addr.String()
+	case FeeExemptionUserID:
+		if id == "" {
+			return ErrFeeExemptionKind
+		}
+	default:
+		return ErrFeeExemptionKind
+	}
+
+	key, err := stub.CreateCompositeKey(feeExemptionKey, []string{kind, id})
+	if err != nil {
+		return err
+	}
+	switch mode {
+	case FeeExemptNone:
+		return stub.DelState(key)
+	case FeeExemptSender, FeeExemptReceiver, FeeExemptBoth:
+	default:
+		return ErrFeeExemptionMode
+	}
+	data, err := json.Marshal(&FeeExemption{Kind: kind, ID: id, Mode: mode})
+	if err != nil {
+		return err
+	}
+	return stub.PutState(key, data)
+}
+
+// QueryFeeExemptions returns all fee exemptions
+func (bt *BaseToken) QueryFeeExemptions() ([]*FeeExemption, error) {
+	return feeExemptions(bt.GetStub())
+}
+
+func feeExemptions(stub shim.ChaincodeStubInterface) ([]*FeeExemption, error) {
+	iter, err := stub.GetStateByPartialCompositeKey(feeExemptionKey, []string{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	exemptions := []*FeeExemption{}
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		exemption := &FeeExemption{}
+		if err = json.Unmarshal(kv.Value, exemption); err != nil {
+			return nil, err
+		}
+		exemptions = append(exemptions, exemption)
+	}
+	return exemptions, nil
+}
+
+// isFeeExempt returns true if the address is exempt from fees as a sender or as a receiver.
+// User IDs are checked only if the address has the user ID, i.e. it's the full address from the ACL
+func isFeeExempt(stub shim.ChaincodeStubInterface, addr *types.Address, asSender bool) (bool, error) {
+	if addr == nil {
+		return false, nil
+	}
+	ids := [][2]string{{FeeExemptionAddress, addr.String()}}
+	if addr.UserID != "" {
+		ids = append(ids, [2]string{FeeExemptionUserID, addr.UserID})
+	}
+
+	for _, id := range ids {
+		key, err := stub.CreateCompositeKey(feeExemptionKey, id[:])
+		if err != nil {
+			return false, err
+		}
+		data, err := stub.GetState(key)
+		if err != nil {
+			return false, err
+		}
+		if len(data) == 0 {
+			continue
+		}
+		exemption := &FeeExemption{}
+		if err = json.Unmarshal(data, exemption); err != nil {
+			return false, err
+		}
+		if exemption.Mode == FeeExemptBoth ||
+			(asSender && exemption.Mode == FeeExemptSender) ||
+			(!asSender && exemption.Mode == FeeExemptReceiver) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// feeExempt returns true if the payer is exempt as a sender or the counterparty is exempt as a receiver
+func feeExempt(stub shim.ChaincodeStubInterface, payer *types.Address, counterparty *types.Address) (bool, error) {
+	exempt, err := isFeeExempt(stub, payer, true)
+	if err != nil || exempt {
+		return exempt, err
+	}
+	return isFeeExempt(stub, counterparty, false)
+}
+
+// QueryPredictTransferFee returns the fee of the transfer between the addresses,
+// it's QueryPredictOperationFee of the transfer
+func (bt *BaseToken) QueryPredictTransferFee(from *types.Address, to *types.Address, amount *big.Int) (*Predict, error) {
+	return bt.QueryPredictOperationFee(FeeOperationTransfer, from, to, amount)
+}
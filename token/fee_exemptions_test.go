@@ -0,0 +1,85 @@
+package token
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	ma "github.com/atomyze-foundation/foundation/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeExemptions(t *testing.T) {
+	mock := ma.NewLedger(t)
+	issuer := mock.NewWallet()
+	feeAddressSetter := mock.NewWallet()
+	feeSetter := mock.NewWallet()
+	feeAggregator := mock.NewWallet()
+	marketMaker := mock.NewWallet()
+	treasury := mock.NewWallet()
+	user := mock.NewWallet()
+
+	vt := &VT{
+		BaseToken{
+			Name:     vtName,
+			Symbol:   "VT",
+			Decimals: 8,
+		},
+	}
+
+	mock.NewChainCode("vt", vt, &core.ContractOptions{}, nil, issuer.Address(), feeSetter.Address(), feeAddressSetter.Address())
+
+	issuer.SignedInvoke("vt", "emitToken", "10000")
+	feeAddressSetter.SignedInvoke("vt", "setFeeAddress", feeAggregator.Address())
+	feeSetter.SignedInvoke("vt", "setFee", "VT", "500000", "1", "0")
+
+	err := user.RawSignedInvokeWithErrorReturned("vt", "setFeeExemption", FeeExemptionAddress, marketMaker.Address(), FeeExemptBoth)
+	assert.EqualError(t, err, ErrFeeExemptionUnauthorized.Error())
+	err = feeSetter.RawSignedInvokeWithErrorReturned("vt", "setFeeExemption", FeeExemptionAddress, marketMaker.Address(), "always")
+	assert.EqualError(t, err, ErrFeeExemptionMode.Error())
+	err = feeSetter.RawSignedInvokeWithErrorReturned("vt", "setFeeExemption", "wallet", marketMaker.Address(), FeeExemptBoth)
+	assert.EqualError(t, err, ErrFeeExemptionKind.Error())
+
+	feeSetter.SignedInvoke("vt", "setFeeExemption", FeeExemptionAddress, marketMaker.Address(), FeeExemptBoth)
+	issuer.SignedInvoke("vt", "setFeeExemption", FeeExemptionAddress, treasury.Address(), FeeExemptReceiver)
+
+	predict := func(from, to *ma.Wallet) string {
+		p := &Predict{}
+		assert.NoError(t, json.Unmarshal([]byte(user.Invoke("vt", "predictTransferFee", from.Address(), to.Address(), "1000")), p))
+		return p.Fee.String()
+	}
+	assert.Equal(t, "5", predict(issuer, user))
+	assert.Equal(t, "0", predict(issuer, marketMaker))
+	assert.Equal(t, "0", predict(user, treasury))
+	assert.Equal(t, "5", predict(treasury, user))
+
+	// exemptions apply to the prediction of any operation, predictFee doesn't know the addresses
+	p := &Predict{}
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke("vt", "predictOperationFee", FeeOperationTransfer, marketMaker.Address(), user.Address(), "1000")), p))
+	assert.Equal(t, "0", p.Fee.String())
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke("vt", "predictOperationFee", FeeOperationTransfer, user.Address(), issuer.Address(), "1000")), p))
+	assert.Equal(t, "5", p.Fee.String())
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke("vt", "predictFee", "1000")), p))
+	assert.Equal(t, "5", p.Fee.String())
+
+	// the market maker is exempt as a receiver and as a sender
+	issuer.SignedInvoke("vt", "transfer", marketMaker.Address(), "2000", "")
+	marketMaker.SignedInvoke("vt", "transfer", user.Address(), "1000", "")
+	issuer.BalanceShouldBe("vt", 8000)
+	marketMaker.BalanceShouldBe("vt", 1000)
+	user.BalanceShouldBe("vt", 1000)
+	feeAggregator.BalanceShouldBe("vt", 0)
+
+	// the treasury is exempt only as a receiver, it pays the min fee sending 100
+	user.SignedInvoke("vt", "transfer", treasury.Address(), "500", "")
+	treasury.SignedInvoke("vt", "transfer", user.Address(), "100", "")
+	treasury.BalanceShouldBe("vt", 399)
+	feeAggregator.BalanceShouldBe("vt", 1)
+
+	var list []*FeeExemption
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke("vt", "feeExemptions")), &list))
+	assert.Len(t, list, 2)
+
+	feeSetter.SignedInvoke("vt", "setFeeExemption", FeeExemptionAddress, marketMaker.Address(), FeeExemptNone)
+	assert.Equal(t, "5", predict(issuer, marketMaker))
+}
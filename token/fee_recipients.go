@@ -0,0 +1,120 @@
+package token
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/proto"
+)
+
+// feeShareTotal is the sum of shares of fee recipients in basis points
+const feeShareTotal = 10000
+
+// fee recipients errors
+var (
+	ErrFeeAddressSetterOnly = errors.New("only the fee address setter can set fee recipients")
+	ErrFeeShares            = errors.New("shares of fee recipients must be positive and sum up to 10000")
+	ErrFeeRecipientTwice    = errors.New("fee recipient is set twice")
+)
+
+// FeeRecipient is a recipient of the share of fees in basis points
+type FeeRecipient struct {
+	Address string `json:"address"`
+	Share   uint32 `json:"share"`
+}
+
+// TxSetFeeRecipients sets recipients which fees are split between instead of the fee address,
+// rawRecipients is a JSON array of FeeRecipient, the empty array deletes recipients
+func (bt *BaseToken) TxSetFeeRecipients(sender *types.Sender, rawRecipients string) error {
+	if err := checkRoleHolder(sender, bt.GetFeeAddressSetter, ErrFeeAddressSetterOnly); err != nil {
+		return err
+	}
+
+	var recipients []*FeeRecipient
+	if err := json.Unmarshal([]byte(rawRecipients), &recipients); err != nil {
+		return err
+	}
+
+	var total uint64
+	seen := make(map[string]struct{}, len(recipients))
+	config := make([]*proto.FeeRecipient, 0, len(recipients))
+	for _, r := range recipients {
+		addr, err := types.AddrFromBase58Check(r.Address)
+		if err != nil {
+			return fmt.Errorf("fee recipient: %w", err)
+		}
+		if _, ok := seen[addr.String()]; ok {
+			return fmt.Errorf("%w: %s", ErrFeeRecipientTwice, addr.String())
+		}
+		seen[addr.String()] = struct{}{}
+		// a share above the total would overflow the sum of shares
+		if r.Share == 0 || r.Share > feeShareTotal {
+			return ErrFeeShares
+		}
+		total += uint64(r.Share)
+		config = append(config, &proto.FeeRecipient{Address: addr.Bytes(), Share: r.Share})
+	}
+	if len(config) != 0 && total != feeShareTotal {
+		return ErrFeeShares
+	}
+
+	if err := bt.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	bt.config.FeeRecipients = config
+	return bt.saveConfig()
+}
+
+// QueryFeeRecipients returns recipients which fees are split between
+func (bt *BaseToken) QueryFeeRecipients() ([]*FeeRecipient, error) {
+	if err := bt.loadConfigUnlessLoaded(); err != nil {
+		return nil, err
+	}
+	recipients := make([]*FeeRecipient, 0, len(bt.config.FeeRecipients))
+	for _, r := range bt.config.FeeRecipients {
+		recipients = append(recipients, &FeeRecipient{Address: types.AddrFromBytes(r.Address).String(), Share: r.Share})
+	}
+	return recipients, nil
+}
+
+// transferFee transfers the fee from the payer to the fee address or splits it between fee recipients.
+// Every recipient gets its share rounded down, the remainder goes to the first recipient
+func (bt *BaseToken) transferFee(currency string, payer *types.Address, fee *big.Int, reason string) error {
+	transfer := func(to *types.Address, amount *big.Int) error {
+		if currency == bt.Symbol {
+			return bt.TokenBalanceTransfer(payer, to, amount, reason)
+		}
+		return bt.AllowedBalanceTransfer(currency, payer, to, amount, reason)
+	}
+
+	if len(bt.config.FeeRecipients) == 0 {
+		if !types.IsValidAddressLen(bt.config.FeeAddress) {
+			return ErrFeeAddressUnset
+		}
+		return transfer(types.AddrFromBytes(bt.config.FeeAddress), fee)
+	}
+
+	amounts := make([]*big.Int, len(bt.config.FeeRecipients))
+	remainder := new(big.Int).Set(fee)
+	for i, r := range bt.config.FeeRecipients {
+		amounts[i] = new(big.Int).Div(
+			new(big.Int).Mul(fee, new(big.Int).SetUint64(uint64(r.Share))),
+			new(big.Int).SetUint64(feeShareTotal),
+		)
+		remainder.Sub(remainder, amounts[i])
+	}
+	amounts[0].Add(amounts[0], remainder)
+
+	for i, r := range bt.config.FeeRecipients {
+		if amounts[i].Sign() == 0 {
+			continue
+		}
+		if err := transfer(types.AddrFromBytes(r.Address), amounts[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	ma "github.com/atomyze-foundation/foundation/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeRecipients(t *testing.T) {
+	mock := ma.NewLedger(t)
+	issuer := mock.NewWallet()
+	feeAddressSetter := mock.NewWallet()
+	feeSetter := mock.NewWallet()
+	platform := mock.NewWallet()
+	partner := mock.NewWallet()
+	reserve := mock.NewWallet()
+	user := mock.NewWallet()
+
+	vt := &VT{
+		BaseToken{
+			Name:     vtName,
+			Symbol:   "VT",
+			Decimals: 8,
+		},
+	}
+
+	mock.NewChainCode("vt", vt, &core.ContractOptions{}, nil, issuer.Address(), feeSetter.Address(), feeAddressSetter.Address())
+
+	issuer.SignedInvoke("vt", "emitToken", "10000")
+	feeSetter.SignedInvoke("vt", "setFee", "VT", "500000", "1", "0")
+
+	recipients := func(shares ...uint32) string {
+		wallets := []*ma.Wallet{platform, partner, reserve}
+		list := make([]*FeeRecipient, 0, len(shares))
+		for i, share := range shares {
+			list = append(list, &FeeRecipient{Address: wallets[i].Address(), Share: share})
+		}
+		data, err := json.Marshal(list)
+		assert.NoError(t, err)
+		return string(data)
+	}
+
+	err := feeSetter.RawSignedInvokeWithErrorReturned("vt", "setFeeRecipients", recipients(5000, 3000, 2000))
+	assert.EqualError(t, err, ErrFeeAddressSetterOnly.Error())
+	err = feeAddressSetter.RawSignedInvokeWithErrorReturned("vt", "setFeeRecipients", recipients(5000, 3000, 1000))
+	assert.EqualError(t, err, ErrFeeShares.Error())
+	// the sum of these shares wraps around to 10000 in uint32
+	err = feeAddressSetter.RawSignedInvokeWithErrorReturned("vt", "setFeeRecipients", recipients(4294962296, 5000, 10000))
+	assert.EqualError(t, err, ErrFeeShares.Error())
+	err = feeAddressSetter.RawSignedInvokeWithErrorReturned("vt", "setFeeRecipients",
+		fmt.Sprintf(`[{"address":"%s","share":5000},{"address":"%s","share":5000}]`, platform.Address(), platform.Address()))
+	assert.ErrorContains(t, err, ErrFeeRecipientTwice.Error())
+
+	feeAddressSetter.SignedInvoke("vt", "setFeeRecipients", recipients(5000, 3000, 2000))
+
+	// the fee of 5 is split into 2, 1 and 1, the remainder goes to the first recipient
+	issuer.SignedInvoke("vt", "transfer", user.Address(), "1001", "")
+	issuer.BalanceShouldBe("vt", 8994)
+	user.BalanceShouldBe("vt", 1001)
+	platform.BalanceShouldBe("vt", 3)
+	partner.BalanceShouldBe("vt", 1)
+	reserve.BalanceShouldBe("vt", 1)
+
+	var list []*FeeRecipient
+	assert.NoError(t, json.Unmarshal([]byte(issuer.Invoke("vt", "feeRecipients")), &list))
+	assert.Len(t, list, 3)
+	assert.Equal(t, uint32(3000), list[1].Share)
+
+	feeAddressSetter.SignedInvoke("vt", "setFeeRecipients", "[]")
+	err = issuer.RawSignedInvokeWithErrorReturned("vt", "transfer", user.Address(), "1001", "")
+	assert.EqualError(t, err, ErrFeeAddressUnset.Error())
+}
@@ -0,0 +1,258 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/atomyze-foundation/foundation/core/helpers"
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/proto"
+)
+
+// operations with fee schedules
+const (
+	FeeOperationTransfer        = "transfer"
+	FeeOperationBuyToken        = "buyToken"
+	FeeOperationBuyBack         = "buyBack"
+	FeeOperationChannelTransfer = "channelTransfer"
+)
+
+var feeOperations = []string{
+	FeeOperationTransfer,
+	FeeOperationBuyToken,
+	FeeOperationBuyBack,
+	FeeOperationChannelTransfer,
+}
+
+// fee schedule errors
+var (
+	ErrFeeSetterOnly   = errors.New("only the fee setter can set fees")
+	ErrFeeOperation    = errors.New("unknown fee operation")
+	ErrFeeCurrency     = errors.New("unknown currency")
+	ErrFeeTier         = errors.New("incorrect fee tier")
+	ErrFeeAddressUnset = errors.New("fee address is not set")
+)
+
+// TxSetFeeSchedule sets the fee schedule of the operation, the schedule without tiers deletes it.
+// The transfer schedule overrides the fee set by TxSetFee until TxSetFee is called again
+func (bt *BaseToken) TxSetFeeSchedule(sender *types.Sender, schedule *proto.FeeSchedule) error {
+	if err := checkRoleHolder(sender, bt.GetFeeSetter, ErrFeeSetterOnly); err != nil {
+		return err
+	}
+	if err := bt.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	if err := bt.verifyFeeSchedule(schedule); err != nil {
+		return err
+	}
+
+	schedules := make([]*proto.FeeSchedule, 0, len(bt.config.FeeSchedules)+1)
+	for _, s := range bt.config.FeeSchedules {
+		if s.Operation != schedule.Operation {
+			schedules = append(schedules, s)
+		}
+	}
+	if len(schedule.Tiers) != 0 {
+		schedules = append(schedules, schedule)
+	}
+	bt.config.FeeSchedules = schedules
+	return bt.saveConfig()
+}
+
+func (bt *BaseToken) verifyFeeSchedule(schedule *proto.FeeSchedule) error {
+	known := false
+	for _, op := range feeOperations {
+		known = known || op == schedule.Operation
+	}
+	if !known {
+		return fmt.Errorf("%w: %s", ErrFeeOperation, schedule.Operation)
+	}
+	if len(schedule.Tiers) == 0 {
+		return nil
+	}
+
+	if schedule.Currency != bt.Symbol {
+		if _, ok, err := bt.GetRateAndLimits("buyToken", schedule.Currency); err != nil || !ok {
+			return ErrFeeCurrency
+		}
+	}
+
+	var prev *big.Int
+	for i, tier := range schedule.Tiers {
+		from, err := feeValue(tier.From)
+		if err != nil {
+			return fmt.Errorf("%w %d: from: %s", ErrFeeTier, i, err.Error())
+		}
+		if prev != nil && from.Cmp(prev) <= 0 {
+			return fmt.Errorf("%w %d: tiers must be in ascending order of from", ErrFeeTier, i)
+		}
+		prev = from
+
+		fee, err := feeValue(tier.Fee)
+		if err != nil {
+			return fmt.Errorf("%w %d: fee: %s", ErrFeeTier, i, err.Error())
+		}
+		if fee.Cmp(new(big.Int).SetInt64(100000000)) > 0 { //nolint:gomnd
+			return fmt.Errorf("%w %d: fee should be equal or less than 100%%", ErrFeeTier, i)
+		}
+		if _, err = feeValue(tier.Flat); err != nil {
+			return fmt.Errorf("%w %d: flat: %s", ErrFeeTier, i, err.Error())
+		}
+		if _, err = feeValue(tier.Cap); err != nil {
+			return fmt.Errorf("%w %d: cap: %s", ErrFeeTier, i, err.Error())
+		}
+	}
+	return nil
+}
+
+// feeValue parses a non-negative decimal value of the fee schedule, empty value is zero
+func feeValue(value string) (*big.Int, error) {
+	if value == "" {
+		return new(big.Int), nil
+	}
+	v, ok := new(big.Int).SetString(value, 10) //nolint:gomnd
+	if !ok || v.Sign() < 0 {
+		return nil, fmt.Errorf("%s isn't a non-negative integer", value)
+	}
+	return v, nil
+}
+
+// QueryFeeSchedules returns fee schedules of operations
+func (bt *BaseToken) QueryFeeSchedules() ([]*proto.FeeSchedule, error) {
+	if err := bt.loadConfigUnlessLoaded(); err != nil {
+		return nil, err
+	}
+	if bt.config.FeeSchedules == nil {
+		return []*proto.FeeSchedule{}, nil
+	}
+	return bt.config.FeeSchedules, nil
+}
+
+// QueryPredictOperationFee returns the predicted fee of the operation paid by the payer. The counterparty is
+// the recipient of transfers and it's ignored for other operations. Exempt addresses
+// and transfers between addresses of the same user aren't charged
+func (bt *BaseToken) QueryPredictOperationFee(operation string, payer *types.Address, counterparty *types.Address, amount *big.Int) (*Predict, error) {
+	stub := bt.GetStub()
+	for _, addr := range []**types.Address{&payer, &counterparty} {
+		fullAddr, err := helpers.GetFullAddress(stub, (*addr).String())
+		if err != nil {
+			return &Predict{}, err
+		}
+		*addr = (*types.Address)(fullAddr)
+	}
+
+	switch operation {
+	case FeeOperationTransfer:
+		if payer.IsUserIDSame(counterparty) {
+			return &Predict{Fee: big.NewInt(0), Currency: bt.Symbol}, nil
+		}
+	case FeeOperationBuyToken, FeeOperationBuyBack:
+		issuer, err := bt.GetIssuer()
+		if err != nil {
+			return &Predict{}, err
+		}
+		counterparty = issuer
+	default:
+		counterparty = nil
+	}
+	return bt.predictOperationFee(operation, payer, counterparty, amount)
+}
+
+// predictOperationFee returns the fee of the operation, it's zero if the payer
+// or the counterparty (if any) is exempt from fees
+func (bt *BaseToken) predictOperationFee(operation string, payer *types.Address, counterparty *types.Address, amount *big.Int) (*Predict, error) {
+	exempt, err := feeExempt(bt.GetStub(), payer, counterparty)
+	if err != nil {
+		return &Predict{}, err
+	}
+	if exempt {
+		return &Predict{Fee: big.NewInt(0), Currency: bt.Symbol}, nil
+	}
+	return bt.calcOperationFee(operation, amount)
+}
+
+// calcOperationFee returns the fee of the operation by its schedule. The transfer without schedule
+// is charged the fee set by TxSetFee, other operations without schedule aren't charged
+func (bt *BaseToken) calcOperationFee(operation string, amount *big.Int) (*Predict, error) {
+	if err := bt.loadConfigUnlessLoaded(); err != nil {
+		return &Predict{}, err
+	}
+	for _, schedule := range bt.config.FeeSchedules {
+		if schedule.Operation == operation {
+			return bt.calcScheduleFee(schedule, amount)
+		}
+	}
+	if operation == FeeOperationTransfer {
+		return bt.calcFee(amount)
+	}
+	return &Predict{Fee: big.NewInt(0), Currency: bt.Symbol}, nil
+}
+
+func (bt *BaseToken) calcScheduleFee(schedule *proto.FeeSchedule, amount *big.Int) (*Predict, error) {
+	var tier *proto.FeeTier
+	for _, t := range schedule.Tiers {
+		from, err := feeValue(t.From)
+		if err != nil {
+			return &Predict{}, err
+		}
+		if from.Cmp(amount) > 0 {
+			break
+		}
+		tier = t
+	}
+	if tier == nil {
+		return &Predict{Fee: big.NewInt(0), Currency: schedule.Currency}, nil
+	}
+
+	percent, err := feeValue(tier.Fee)
+	if err != nil {
+		return &Predict{}, err
+	}
+	fee := new(big.Int).Div(
+		new(big.Int).Mul(amount, percent),
+		new(big.Int).Exp(new(big.Int).SetUint64(10), new(big.Int).SetUint64(feeDecimals), nil), //nolint:gomnd
+	)
+
+	if schedule.Currency != bt.Symbol {
+		rate, ok, err := bt.GetRateAndLimits("buyToken", schedule.Currency)
+		if err != nil {
+			return &Predict{}, err
+		}
+		if !ok {
+			return &Predict{}, errors.New("incorrect fee currency")
+		}
+		fee = new(big.Int).Div(
+			new(big.Int).Mul(fee, new(big.Int).SetBytes(rate.Rate)),
+			new(big.Int).Exp(new(big.Int).SetUint64(10), new(big.Int).SetUint64(RateDecimal), nil), //nolint:gomnd
+		)
+	}
+
+	flat, err := feeValue(tier.Flat)
+	if err != nil {
+		return &Predict{}, err
+	}
+	fee.Add(fee, flat)
+
+	cp, err := feeValue(tier.Cap)
+	if err != nil {
+		return &Predict{}, err
+	}
+	if cp.Sign() > 0 && fee.Cmp(cp) > 0 {
+		fee = cp
+	}
+	return &Predict{Currency: schedule.Currency, Fee: fee}, nil
+}
+
+// chargeFee transfers the fee of the operation from the payer to the fee address or fee recipients
+// unless the payer or the counterparty (if any) is exempt from fees
+func (bt *BaseToken) chargeFee(operation string, payer *types.Address, counterparty *types.Address, amount *big.Int, reason string) error {
+	fee, err := bt.predictOperationFee(operation, payer, counterparty, amount)
+	if err != nil {
+		return err
+	}
+	if fee.Fee.Sign() == 0 || fee.Currency == "" {
+		return nil
+	}
+	return bt.transferFee(fee.Currency, payer, fee.Fee, reason+" fee")
+}
@@ -0,0 +1,107 @@
+package token
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	ma "github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeSchedules(t *testing.T) {
+	mock := ma.NewLedger(t)
+	issuer := mock.NewWallet()
+	feeAddressSetter := mock.NewWallet()
+	feeSetter := mock.NewWallet()
+	feeAggregator := mock.NewWallet()
+	user := mock.NewWallet()
+
+	vt := &VT{
+		BaseToken{
+			Name:     vtName,
+			Symbol:   "VT",
+			Decimals: 8,
+		},
+	}
+
+	mock.NewChainCode("vt", vt, &core.ContractOptions{}, nil, issuer.Address(), feeSetter.Address(), feeAddressSetter.Address())
+
+	issuer.SignedInvoke("vt", "emitToken", "100000")
+	feeAddressSetter.SignedInvoke("vt", "setFeeAddress", feeAggregator.Address())
+
+	setSchedule := func(wallet *ma.Wallet, schedule *proto.FeeSchedule) error {
+		data, err := json.Marshal(schedule)
+		assert.NoError(t, err)
+		return wallet.RawSignedInvokeWithErrorReturned("vt", "setFeeSchedule", string(data))
+	}
+
+	transferSchedule := &proto.FeeSchedule{
+		Operation: FeeOperationTransfer,
+		Currency:  "VT",
+		Tiers: []*proto.FeeTier{
+			{From: "0", Fee: "1000000", Flat: "1"},
+			{From: "1000", Fee: "500000", Cap: "8"},
+		},
+	}
+	assert.EqualError(t, setSchedule(issuer, transferSchedule), ErrFeeSetterOnly.Error())
+	assert.ErrorContains(t, setSchedule(feeSetter, &proto.FeeSchedule{Operation: "swap"}), ErrFeeOperation.Error())
+	assert.ErrorContains(t, setSchedule(feeSetter, &proto.FeeSchedule{
+		Operation: FeeOperationTransfer,
+		Currency:  "VT",
+		Tiers:     []*proto.FeeTier{{From: "10"}, {From: "10"}},
+	}), ErrFeeTier.Error())
+	assert.NoError(t, setSchedule(feeSetter, transferSchedule))
+	assert.NoError(t, setSchedule(feeSetter, &proto.FeeSchedule{
+		Operation: FeeOperationChannelTransfer,
+		Currency:  "VT",
+		Tiers:     []*proto.FeeTier{{From: "0", Flat: "5"}},
+	}))
+
+	for _, tc := range []struct {
+		operation string
+		amount    string
+		fee       string
+	}{
+		{FeeOperationTransfer, "500", "6"},
+		{FeeOperationTransfer, "1000", "5"},
+		{FeeOperationTransfer, "10000", "8"},
+		{FeeOperationChannelTransfer, "10000", "5"},
+		{FeeOperationBuyToken, "10000", "0"},
+	} {
+		predict := &Predict{}
+		assert.NoError(t, json.Unmarshal([]byte(issuer.Invoke("vt", "predictOperationFee", tc.operation, user.Address(), issuer.Address(), tc.amount)), predict))
+		assert.Equal(t, tc.fee, predict.Fee.String(), tc.operation, tc.amount)
+	}
+
+	issuer.SignedInvoke("vt", "transfer", user.Address(), "10000", "")
+	user.SignedInvoke("vt", "transfer", issuer.Address(), "500", "")
+	user.SignedInvoke("vt", "channelTransferByCustomer", uuid.NewString(), "CC", "VT", "1000")
+	user.BalanceShouldBe("vt", 8489)
+	feeAggregator.BalanceShouldBe("vt", 19)
+
+	// the amount of tokens of another channel isn't charged
+	user.AddAllowedBalance("vt", "CC", 1000)
+	user.SignedInvoke("vt", "channelTransferByCustomer", uuid.NewString(), "CC", "CC", "1000")
+	user.BalanceShouldBe("vt", 8489)
+	feeAggregator.BalanceShouldBe("vt", 19)
+
+	// QueryPredictFee predicts the transfer fee by the schedule
+	predict := &Predict{}
+	assert.NoError(t, json.Unmarshal([]byte(issuer.Invoke("vt", "predictFee", "10000")), predict))
+	assert.Equal(t, "8", predict.Fee.String())
+
+	// TxSetFee replaces the transfer schedule
+	feeSetter.SignedInvoke("vt", "setFee", "VT", "500000", "1", "0")
+	assert.NoError(t, json.Unmarshal([]byte(issuer.Invoke("vt", "predictFee", "10000")), predict))
+	assert.Equal(t, "50", predict.Fee.String())
+	assert.NoError(t, json.Unmarshal([]byte(issuer.Invoke("vt", "predictOperationFee", FeeOperationTransfer, user.Address(), issuer.Address(), "10000")), predict))
+	assert.Equal(t, "50", predict.Fee.String())
+
+	assert.NoError(t, setSchedule(feeSetter, &proto.FeeSchedule{Operation: FeeOperationChannelTransfer}))
+	var schedules []*proto.FeeSchedule
+	assert.NoError(t, json.Unmarshal([]byte(issuer.Invoke("vt", "feeSchedules")), &schedules))
+	assert.Empty(t, schedules)
+}
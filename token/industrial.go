@@ -0,0 +1,227 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/initialize"
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/proto"
+	pb "github.com/golang/protobuf/proto" //nolint:staticcheck
+)
+
+// industrial token errors
+var (
+	ErrIndustrialInitialized = errors.New("industrial token is already initialized")
+	ErrGroupID               = errors.New("group id must be non-empty, unique and without '_' and ','")
+	ErrUnknownGroup          = errors.New("unknown group")
+)
+
+// Group is a group of the industrial token with its own emission and maturity
+type Group struct {
+	ID       string
+	Emission *big.Int
+	Maturity time.Time
+	Note     string
+}
+
+// IndustrialBaseToken is the base industrial token, its tokens are issued in groups
+// and balances are kept per group
+type IndustrialBaseToken struct {
+	core.BaseContract
+	Name            string
+	Symbol          string
+	Decimals        uint
+	UnderlyingAsset string
+	DeliveryForm    string
+	UnitOfMeasure   string
+	TokensForUnit   string
+	PaymentTerms    string
+	Price           string
+
+	config *proto.Industrial
+}
+
+// InitSchema returns init args of the token: the issuer, the fee setter and the fee address setter
+func (it *IndustrialBaseToken) InitSchema() *initialize.Schema {
+	return tokenInitSchema()
+}
+
+// Issuer returns the issuer of the token from the role registry, it panics if the issuer can't be read,
+// methods should use GetIssuer
+func (it *IndustrialBaseToken) Issuer() *types.Address {
+	return mustRoleHolder(it.GetIssuer)
+}
+
+// FeeSetter returns the fee setter of the token from the role registry, it panics if the fee setter
+// can't be read or isn't set, methods should use GetFeeSetter
+func (it *IndustrialBaseToken) FeeSetter() *types.Address {
+	return mustRoleHolder(it.GetFeeSetter)
+}
+
+// FeeAddressSetter returns the fee address setter of the token from the role registry, it panics
+// if the fee address setter can't be read or isn't set, methods should use GetFeeAddressSetter
+func (it *IndustrialBaseToken) FeeAddressSetter() *types.Address {
+	return mustRoleHolder(it.GetFeeAddressSetter)
+}
+
+// GetIssuer returns the issuer of the token from the role registry
+func (it *IndustrialBaseToken) GetIssuer() (*types.Address, error) {
+	return it.GetRoleHolder(core.RoleAdmin)
+}
+
+// GetFeeSetter returns the fee setter of the token from the role registry
+func (it *IndustrialBaseToken) GetFeeSetter() (*types.Address, error) {
+	return it.GetRoleHolder(core.RoleFeeSetter)
+}
+
+// GetFeeAddressSetter returns the fee address setter of the token from the role registry
+func (it *IndustrialBaseToken) GetFeeAddressSetter() (*types.Address, error) {
+	return it.GetRoleHolder(core.RoleFeeAddressSetter)
+}
+
+// GetID returns the ID of the token
+func (it *IndustrialBaseToken) GetID() string {
+	return it.Symbol
+}
+
+func (it *IndustrialBaseToken) loadConfigUnlessLoaded() error {
+	data, err := it.GetStub().GetState(metadataKey)
+	if err != nil {
+		return err
+	}
+	if it.config == nil {
+		it.config = &proto.Industrial{}
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+	return pb.Unmarshal(data, it.config)
+}
+
+func (it *IndustrialBaseToken) saveConfig() error {
+	data, err := pb.Marshal(it.config)
+	if err != nil {
+		return err
+	}
+	return it.GetStub().PutState(metadataKey, data)
+}
+
+// groupToken returns the name of the industrial balance of the group
+func (it *IndustrialBaseToken) groupToken(group string) string {
+	return it.Symbol + "_" + group
+}
+
+// checkGroup returns ErrUnknownGroup if the token has no such group
+func (it *IndustrialBaseToken) checkGroup(group string) error {
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	for _, g := range it.config.Groups {
+		if g.Id == group {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownGroup, group)
+}
+
+// Initialize creates groups of the token and issues their emission to the issuer,
+// the token can be initialized once
+func (it *IndustrialBaseToken) Initialize(groups []Group) error {
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	if it.config.Initialized {
+		return ErrIndustrialInitialized
+	}
+
+	issuer, err := it.GetIssuer()
+	if err != nil {
+		return err
+	}
+	ids := make(map[string]struct{}, len(groups))
+	for _, g := range groups {
+		if _, ok := ids[g.ID]; ok || g.ID == "" || strings.ContainsAny(g.ID, "_,") {
+			return fmt.Errorf("%w: %s", ErrGroupID, g.ID)
+		}
+		ids[g.ID] = struct{}{}
+		if g.Emission == nil || g.Emission.Sign() <= 0 {
+			return fmt.Errorf("emission of the group %s should be more than zero", g.ID)
+		}
+
+		it.config.Groups = append(it.config.Groups, &proto.IndustrialGroup{
+			Id:       g.ID,
+			Emission: g.Emission.Bytes(),
+			Maturity: g.Maturity.Unix(),
+			Note:     g.Note,
+		})
+		if err := it.IndustrialBalanceAdd(it.groupToken(g.ID), issuer, g.Emission, "initial emit"); err != nil {
+			return err
+		}
+	}
+	it.config.Initialized = true
+	return it.saveConfig()
+}
+
+// ChangeGroupMetadata changes the maturity and the note of the group
+func (it *IndustrialBaseToken) ChangeGroupMetadata(group string, maturity time.Time, note string) error {
+	if err := it.checkGroup(group); err != nil {
+		return err
+	}
+	for _, g := range it.config.Groups {
+		if g.Id == group {
+			g.Maturity = maturity.Unix()
+			g.Note = note
+		}
+	}
+	return it.saveConfig()
+}
+
+// GetRateAndLimits returns rate and limits for the deal type and currency
+func (it *IndustrialBaseToken) GetRateAndLimits(dealType string, currency string) (*proto.TokenRate, bool, error) {
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return nil, false, err
+	}
+	rate, exists := findRate(it.config.Rates, dealType, currency)
+	return rate, exists, nil
+}
+
+// CheckLimitsAndPrice checks the validity of the rate, limits and price
+func (it *IndustrialBaseToken) CheckLimitsAndPrice(method string, amount *big.Int, currency string) (*big.Int, error) {
+	rate, exists, err := it.GetRateAndLimits(method, currency)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	return priceOf(it.GetStub(), rate, exists, amount)
+}
+
+// chargeFee transfers the fee of the amount from the payer to the fee address unless the payer or the counterparty
+// is exempt from fees, the fee in the token is charged from the same group. Unlike BaseToken
+// the industrial token doesn't have fee recipients, the whole fee goes to the fee address
+func (it *IndustrialBaseToken) chargeFee(group string, payer *types.Address, counterparty *types.Address, amount *big.Int, reason string) error {
+	exempt, err := feeExempt(it.GetStub(), payer, counterparty)
+	if err != nil || exempt {
+		return err
+	}
+
+	fee, err := calcTokenFee(it.config.Fee, it.Symbol, it.config.Rates, amount)
+	if err != nil {
+		return err
+	}
+	if fee.Fee.Sign() == 0 {
+		return nil
+	}
+	if !types.IsValidAddressLen(it.config.FeeAddress) {
+		return ErrFeeAddressUnset
+	}
+	feeAddress := types.AddrFromBytes(it.config.FeeAddress)
+	if fee.Currency == it.Symbol {
+		return it.IndustrialBalanceTransfer(it.groupToken(group), payer, feeAddress, fee.Fee, reason+" fee")
+	}
+	return it.AllowedBalanceTransfer(fee.Currency, payer, feeAddress, fee.Fee, reason+" fee")
+}
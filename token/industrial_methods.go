@@ -0,0 +1,308 @@
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/atomyze-foundation/foundation/core/helpers"
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+)
+
+// IndustrialMetadata is a struct for metadata of the industrial token
+type IndustrialMetadata struct {
+	Name            string                     `json:"name"`
+	Symbol          string                     `json:"symbol"`
+	Decimals        uint                       `json:"decimals"`
+	UnderlyingAsset string                     `json:"underlying_asset"` //nolint:tagliatelle
+	DeliveryForm    string                     `json:"deliveryForm"`
+	UnitOfMeasure   string                     `json:"unitOfMeasure"`
+	TokensForUnit   string                     `json:"tokensForUnit"`
+	PaymentTerms    string                     `json:"paymentTerms"`
+	Price           string                     `json:"price"`
+	Issuer          string                     `json:"issuer"`
+	Methods         []string                   `json:"methods"`
+	Groups          []*IndustrialGroupMetadata `json:"groups"`
+	Fee             *Fee                       `json:"fee"`
+	Rates           []*MetadataRate            `json:"rates"`
+}
+
+// IndustrialGroupMetadata is a struct for metadata of the group
+type IndustrialGroupMetadata struct {
+	Name         string    `json:"name"`
+	Amount       *big.Int  `json:"amount"`
+	MaturityDate time.Time `json:"maturityDate"`
+	Note         string    `json:"note"`
+}
+
+// QueryMetadata returns IndustrialMetadata with groups of the token
+func (it *IndustrialBaseToken) QueryMetadata() (*IndustrialMetadata, error) {
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return &IndustrialMetadata{}, err
+	}
+	issuer, err := it.GetIssuer()
+	if err != nil {
+		return &IndustrialMetadata{}, err
+	}
+	m := &IndustrialMetadata{
+		Name:            it.Name,
+		Symbol:          it.Symbol,
+		Decimals:        it.Decimals,
+		UnderlyingAsset: it.UnderlyingAsset,
+		DeliveryForm:    it.DeliveryForm,
+		UnitOfMeasure:   it.UnitOfMeasure,
+		TokensForUnit:   it.TokensForUnit,
+		PaymentTerms:    it.PaymentTerms,
+		Price:           it.Price,
+		Issuer:          issuer.String(),
+		Methods:         it.GetMethods(),
+		Groups:          []*IndustrialGroupMetadata{},
+		Fee:             &Fee{},
+	}
+	for _, g := range it.config.Groups {
+		m.Groups = append(m.Groups, &IndustrialGroupMetadata{
+			Name:         g.Id,
+			Amount:       new(big.Int).SetBytes(g.Emission),
+			MaturityDate: time.Unix(g.Maturity, 0).UTC(),
+			Note:         g.Note,
+		})
+	}
+	if types.IsValidAddressLen(it.config.FeeAddress) {
+		m.Fee.Address = types.AddrFromBytes(it.config.FeeAddress).String()
+	}
+	if it.config.Fee != nil {
+		m.Fee.Currency = it.config.Fee.Currency
+		m.Fee.Fee = new(big.Int).SetBytes(it.config.Fee.Fee)
+		m.Fee.Floor = new(big.Int).SetBytes(it.config.Fee.Floor)
+		m.Fee.Cap = new(big.Int).SetBytes(it.config.Fee.Cap)
+	}
+	for _, r := range it.config.Rates {
+		m.Rates = append(m.Rates, &MetadataRate{
+			DealType:   r.DealType,
+			Currency:   r.Currency,
+			Rate:       new(big.Int).SetBytes(r.Rate),
+			Min:        new(big.Int).SetBytes(r.Min),
+			Max:        new(big.Int).SetBytes(r.Max),
+			ValidFrom:  r.ValidFrom,
+			ValidUntil: r.ValidUntil,
+		})
+	}
+	return m, nil
+}
+
+// QueryIndustrialBalanceOf returns balances of the address by groups
+func (it *IndustrialBaseToken) QueryIndustrialBalanceOf(address *types.Address) (map[string]string, error) {
+	return it.IndustrialBalanceGet(address)
+}
+
+// QueryAllowedBalanceOf returns allowed balance
+func (it *IndustrialBaseToken) QueryAllowedBalanceOf(address *types.Address, token string) (*big.Int, error) {
+	return it.AllowedBalanceGet(token, address)
+}
+
+// TxTransferIndustrial transfers tokens of the group from one account to another
+// and charges the fee from the sender
+func (it *IndustrialBaseToken) TxTransferIndustrial(sender *types.Sender, to *types.Address, amount *big.Int, group string, _ string) error { // ref
+	if sender.Equal(to) {
+		return errors.New("impossible operation")
+	}
+	if amount.Cmp(big.NewInt(0)) == 0 {
+		return errors.New("amount should be more than zero")
+	}
+	if err := it.checkGroup(group); err != nil {
+		return err
+	}
+	if it.config.Fee != nil && len(it.config.FeeAddress) == 0 {
+		return ErrFeeAddressUnset
+	}
+
+	if err := it.ChargeVelocity(sender.Address(), it.groupToken(group), amount); err != nil {
+		return err
+	}
+	if err := it.IndustrialBalanceTransfer(it.groupToken(group), sender.Address(), to, amount, "transfer"); err != nil {
+		return err
+	}
+
+	fullAdr, err := helpers.GetFullAddress(it.GetStub(), to.String())
+	if err != nil {
+		return err
+	}
+	if sender.Address().IsUserIDSame((*types.Address)(fullAdr)) {
+		return nil
+	}
+	return it.chargeFee(group, sender.Address(), (*types.Address)(fullAdr), amount, "transfer")
+}
+
+// QueryPredictFee returns the predicted fee of the transfer
+func (it *IndustrialBaseToken) QueryPredictFee(amount *big.Int) (*Predict, error) {
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return &Predict{}, err
+	}
+	return calcTokenFee(it.config.Fee, it.Symbol, it.config.Rates, amount)
+}
+
+// TxSetFee sets the fee
+func (it *IndustrialBaseToken) TxSetFee(sender *types.Sender, currency string, fee *big.Int, floor *big.Int, cap *big.Int) error {
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	if err := checkRoleHolder(sender, it.GetFeeSetter, errors.New("unauthorized")); err != nil {
+		return err
+	}
+	if fee.Cmp(new(big.Int).SetInt64(100000000)) > 0 { //nolint:gomnd
+		return errors.New("fee should be equal or less than 100%")
+	}
+	if cap.Cmp(big.NewInt(0)) > 0 && floor.Cmp(cap) > 0 {
+		return errors.New("incorrect limits")
+	}
+	tokenFee, err := newTokenFee(it.Symbol, it.config.Rates, currency, fee, floor, cap)
+	if err != nil {
+		return err
+	}
+	it.config.Fee = tokenFee
+	return it.saveConfig()
+}
+
+// TxSetFeeExemption sets the fee exemption of the address or of the user ID like BaseToken
+func (it *IndustrialBaseToken) TxSetFeeExemption(sender *types.Sender, kind string, id string, mode string) error {
+	if err := checkFeeExemptionManager(sender, it.GetIssuer, it.GetFeeSetter); err != nil {
+		return err
+	}
+	return setFeeExemption(it.GetStub(), kind, id, mode)
+}
+
+// QueryFeeExemptions returns all fee exemptions
+func (it *IndustrialBaseToken) QueryFeeExemptions() ([]*FeeExemption, error) {
+	return feeExemptions(it.GetStub())
+}
+
+// TxSetFeeAddress sets the fee address
+func (it *IndustrialBaseToken) TxSetFeeAddress(sender *types.Sender, address *types.Address) error {
+	if err := checkRoleHolder(sender, it.GetFeeAddressSetter, errors.New("unauthorized")); err != nil {
+		return err
+	}
+
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	it.config.FeeAddress = address.Bytes()
+	return it.saveConfig()
+}
+
+// TxSetRate sets token rate to an asset for a type of deal, the rate is valid until it's changed
+func (it *IndustrialBaseToken) TxSetRate(sender *types.Sender, dealType string, currency string, rate *big.Int) error {
+	return it.TxSetRateWithValidity(sender, dealType, currency, rate, 0, 0)
+}
+
+// TxSetRateWithValidity sets token rate to an asset for a type of deal which is valid from validFrom
+// until validUntil in unix seconds, zero means no bound
+func (it *IndustrialBaseToken) TxSetRateWithValidity(sender *types.Sender, dealType string, currency string, rate *big.Int, validFrom int64, validUntil int64) error {
+	if err := checkRoleHolder(sender, it.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
+	}
+	if validFrom < 0 || validUntil < 0 || (validUntil != 0 && validUntil <= validFrom) {
+		return ErrRateValidity
+	}
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	rates, err := setTokenRate(it.GetStub(), it.config.Rates, it.Symbol, dealType, currency, rate, validFrom, validUntil)
+	if err != nil {
+		return err
+	}
+	it.config.Rates = rates
+	return it.saveConfig()
+}
+
+// TxSetLimits sets limits for a deal type and an asset
+func (it *IndustrialBaseToken) TxSetLimits(sender *types.Sender, dealType string, currency string, min *big.Int, max *big.Int) error {
+	if err := checkRoleHolder(sender, it.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
+	}
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	if err := setRateLimits(it.GetStub(), it.config.Rates, dealType, currency, min, max); err != nil {
+		return err
+	}
+	return it.saveConfig()
+}
+
+// TxDeleteRate - deletes rate from state
+func (it *IndustrialBaseToken) TxDeleteRate(sender *types.Sender, dealType string, currency string) error {
+	if err := checkRoleHolder(sender, it.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
+	}
+	if err := it.loadConfigUnlessLoaded(); err != nil {
+		return err
+	}
+	rates, deleted, err := deleteTokenRate(it.GetStub(), it.config.Rates, it.Symbol, dealType, currency)
+	if err != nil || !deleted {
+		return err
+	}
+	it.config.Rates = rates
+	return it.saveConfig()
+}
+
+// QueryRateHistory returns changes of the rate for the deal type and currency from the oldest one
+func (it *IndustrialBaseToken) QueryRateHistory(dealType string, currency string, pageSize int64, bookmark string) (*RateHistory, error) {
+	return rateHistory(it.GetStub(), dealType, currency, pageSize, bookmark)
+}
+
+// TxBuyToken buys tokens of the group from the issuer for an asset and charges the fee from the buyer
+func (it *IndustrialBaseToken) TxBuyToken(sender *types.Sender, amount *big.Int, currency string, group string) error {
+	issuer, err := it.GetIssuer()
+	if err != nil {
+		return err
+	}
+	if sender.Equal(issuer) {
+		return errors.New("impossible operation")
+	}
+	if amount.Cmp(big.NewInt(0)) == 0 {
+		return errors.New("amount should be more than zero")
+	}
+	if err := it.checkGroup(group); err != nil {
+		return err
+	}
+
+	price, err := it.CheckLimitsAndPrice("buyToken", amount, currency)
+	if err != nil {
+		return err
+	}
+	if err = it.AllowedBalanceTransfer(currency, sender.Address(), issuer, price, "buyToken"); err != nil {
+		return err
+	}
+	if err = it.IndustrialBalanceTransfer(it.groupToken(group), issuer, sender.Address(), amount, "buyToken"); err != nil {
+		return err
+	}
+	return it.chargeFee(group, sender.Address(), issuer, amount, "buyToken")
+}
+
+// TxBuyBack buys back tokens of the group for an asset and charges the fee from the seller
+func (it *IndustrialBaseToken) TxBuyBack(sender *types.Sender, amount *big.Int, currency string, group string) error {
+	issuer, err := it.GetIssuer()
+	if err != nil {
+		return err
+	}
+	if sender.Equal(issuer) {
+		return errors.New("impossible operation")
+	}
+	if amount.Cmp(big.NewInt(0)) == 0 {
+		return errors.New("amount should be more than zero")
+	}
+	if err := it.checkGroup(group); err != nil {
+		return err
+	}
+
+	price, err := it.CheckLimitsAndPrice("buyBack", amount, currency)
+	if err != nil {
+		return err
+	}
+	if err = it.AllowedBalanceTransfer(currency, issuer, sender.Address(), price, "buyBack"); err != nil {
+		return err
+	}
+	if err = it.IndustrialBalanceTransfer(it.groupToken(group), sender.Address(), issuer, amount, "buyBack"); err != nil {
+		return err
+	}
+	return it.chargeFee(group, sender.Address(), issuer, amount, "buyBack")
+}
@@ -0,0 +1,107 @@
+package token
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	ma "github.com/atomyze-foundation/foundation/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// IT is a test industrial token
+type IT struct {
+	IndustrialBaseToken
+}
+
+// TxInitialize creates groups of the token
+func (it *IT) TxInitialize(sender *types.Sender) error {
+	if err := checkRoleHolder(sender, it.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
+	}
+	return it.Initialize([]Group{
+		{ID: "202009", Emission: big.NewInt(10000), Maturity: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC), Note: "first"},
+		{ID: "202010", Emission: big.NewInt(5000), Maturity: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC), Note: "second"},
+	})
+}
+
+func TestIndustrialBaseToken(t *testing.T) {
+	mock := ma.NewLedger(t)
+	issuer := mock.NewWallet()
+	feeAddress := mock.NewWallet()
+	user1 := mock.NewWallet()
+	user2 := mock.NewWallet()
+
+	it := &IT{
+		IndustrialBaseToken{
+			Name:     "Industrial Token",
+			Symbol:   "IT",
+			Decimals: 8,
+		},
+	}
+	mock.NewChainCode("it", it, &core.ContractOptions{}, nil, issuer.Address(), issuer.Address(), issuer.Address())
+
+	issuer.SignedInvoke("it", "initialize")
+	err := issuer.RawSignedInvokeWithErrorReturned("it", "initialize")
+	assert.EqualError(t, err, ErrIndustrialInitialized.Error())
+
+	metadata := mock.IndustrialMetadata("it")
+	assert.Equal(t, "IT", metadata.Symbol)
+	assert.Len(t, metadata.Groups, 2)
+	assert.Equal(t, "202010", metadata.Groups[1].Name)
+	assert.Equal(t, "5000", metadata.Groups[1].Amount.String())
+	assert.Equal(t, time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC), metadata.Groups[1].MaturityDate)
+	issuer.IndustrialBalanceShouldBe("it", "202009", 10000)
+
+	issuer.SignedInvoke("it", "transferIndustrial", user1.Address(), "1000", "202009", "")
+	err = issuer.RawSignedInvokeWithErrorReturned("it", "transferIndustrial", user1.Address(), "1000", "202011", "")
+	assert.ErrorContains(t, err, ErrUnknownGroup.Error())
+
+	issuer.SignedInvoke("it", "setFee", "IT", "500000", "1", "0")
+	err = user1.RawSignedInvokeWithErrorReturned("it", "transferIndustrial", user2.Address(), "100", "202009", "")
+	assert.EqualError(t, err, ErrFeeAddressUnset.Error())
+	issuer.SignedInvoke("it", "setFeeAddress", feeAddress.Address())
+	assert.Equal(t, `{"currency":"IT","fee":"5"}`, user1.Invoke("it", "predictFee", "1000"))
+
+	user1.SignedInvoke("it", "transferIndustrial", user2.Address(), "100", "202009", "")
+	user1.IndustrialBalanceShouldBe("it", "202009", 899)
+	user2.IndustrialBalanceShouldBe("it", "202009", 100)
+	feeAddress.IndustrialBalanceShouldBe("it", "202009", 1)
+
+	// the receiver exempt from fees isn't charged like in BaseToken
+	err = user1.RawSignedInvokeWithErrorReturned("it", "setFeeExemption", FeeExemptionAddress, user2.Address(), FeeExemptReceiver)
+	assert.EqualError(t, err, ErrFeeExemptionUnauthorized.Error())
+	issuer.SignedInvoke("it", "setFeeExemption", FeeExemptionAddress, user2.Address(), FeeExemptReceiver)
+	user1.SignedInvoke("it", "transferIndustrial", user2.Address(), "100", "202009", "")
+	user1.IndustrialBalanceShouldBe("it", "202009", 799)
+	user2.IndustrialBalanceShouldBe("it", "202009", 200)
+	feeAddress.IndustrialBalanceShouldBe("it", "202009", 1)
+	issuer.SignedInvoke("it", "setFeeExemption", FeeExemptionAddress, user2.Address(), FeeExemptNone)
+
+	issuer.SignedInvoke("it", "setRate", "buyToken", "usd", "100000000")
+	issuer.SignedInvoke("it", "setRate", "buyBack", "usd", "100000000")
+	issuer.SignedInvoke("it", "setLimits", "buyToken", "usd", "1", "10")
+	user2.AddAllowedBalance("it", "usd", 5)
+
+	err = user2.RawSignedInvokeWithErrorReturned("it", "buyToken", "20", "usd", "202010")
+	assert.EqualError(t, err, "amount out of limits")
+	// buying and buying back are charged like in BaseToken, the minimum fee is 1
+	user2.SignedInvoke("it", "buyToken", "3", "usd", "202010")
+	user2.IndustrialBalanceShouldBe("it", "202010", 2)
+	feeAddress.IndustrialBalanceShouldBe("it", "202010", 1)
+	user2.SignedInvoke("it", "buyBack", "1", "usd", "202010")
+	user2.IndustrialBalanceShouldBe("it", "202010", 0)
+	user2.AllowedBalanceShouldBe("it", "usd", 3)
+	feeAddress.IndustrialBalanceShouldBe("it", "202010", 2)
+	issuer.IndustrialBalanceShouldBe("it", "202010", 4998)
+
+	// the issuer is the counterparty of buying, buyers aren't charged if it's exempt from fees
+	issuer.SignedInvoke("it", "setFeeExemption", FeeExemptionAddress, issuer.Address(), FeeExemptBoth)
+	user2.SignedInvoke("it", "buyToken", "2", "usd", "202010")
+	user2.IndustrialBalanceShouldBe("it", "202010", 2)
+	feeAddress.IndustrialBalanceShouldBe("it", "202010", 2)
+	assert.Len(t, mock.IndustrialMetadata("it").Rates, 2)
+}
@@ -2,12 +2,10 @@ package token
 
 import (
 	"errors"
-	"fmt"
 
 	"github.com/atomyze-foundation/foundation/core"
 	"github.com/atomyze-foundation/foundation/core/types"
 	"github.com/atomyze-foundation/foundation/core/types/big"
-	"github.com/atomyze-foundation/foundation/proto"
 )
 
 // Metadata is a struct for metadata
@@ -18,18 +16,21 @@ type Metadata struct {
 	UnderlyingAsset string          `json:"underlying_asset"` //nolint:tagliatelle
 	Issuer          string          `json:"issuer"`
 	Methods         []string        `json:"methods"`
-	TotalEmission   *big.Int        `json:"total_emission"` //nolint:tagliatelle
+	TotalEmission   *big.Int        `json:"total_emission"`       //nolint:tagliatelle
+	MaxSupply       *big.Int        `json:"max_supply,omitempty"` //nolint:tagliatelle
 	Fee             *Fee            `json:"fee"`
 	Rates           []*MetadataRate `json:"rates"`
 }
 
 // MetadataRate is a struct for rate
 type MetadataRate struct {
-	DealType string   `json:"deal_type"` //nolint:tagliatelle
-	Currency string   `json:"currency"`
-	Rate     *big.Int `json:"rate"`
-	Min      *big.Int `json:"min"`
-	Max      *big.Int `json:"max"`
+	DealType   string   `json:"deal_type"` //nolint:tagliatelle
+	Currency   string   `json:"currency"`
+	Rate       *big.Int `json:"rate"`
+	Min        *big.Int `json:"min"`
+	Max        *big.Int `json:"max"`
+	ValidFrom  int64    `json:"valid_from,omitempty"`  //nolint:tagliatelle
+	ValidUntil int64    `json:"valid_until,omitempty"` //nolint:tagliatelle
 }
 
 // Fee is a struct for fee
@@ -46,16 +47,23 @@ func (bt *BaseToken) QueryMetadata() (*Metadata, error) {
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return &Metadata{}, err
 	}
+	issuer, err := bt.GetIssuer()
+	if err != nil {
+		return &Metadata{}, err
+	}
 	m := &Metadata{
 		Name:            bt.Name,
 		Symbol:          bt.Symbol,
 		Decimals:        bt.Decimals,
 		UnderlyingAsset: bt.UnderlyingAsset,
-		Issuer:          bt.Issuer().String(),
+		Issuer:          issuer.String(),
 		Methods:         bt.GetMethods(),
 		TotalEmission:   new(big.Int).SetBytes(bt.config.TotalEmission),
 		Fee:             &Fee{},
 	}
+	if len(bt.config.MaxSupply) != 0 {
+		m.MaxSupply = new(big.Int).SetBytes(bt.config.MaxSupply)
+	}
 	if types.IsValidAddressLen(bt.config.FeeAddress) {
 		m.Fee.Address = types.AddrFromBytes(bt.config.FeeAddress).String()
 	}
@@ -67,11 +75,13 @@ func (bt *BaseToken) QueryMetadata() (*Metadata, error) {
 	}
 	for _, r := range bt.config.Rates {
 		m.Rates = append(m.Rates, &MetadataRate{
-			DealType: r.DealType,
-			Currency: r.Currency,
-			Rate:     new(big.Int).SetBytes(r.Rate),
-			Min:      new(big.Int).SetBytes(r.Min),
-			Max:      new(big.Int).SetBytes(r.Max),
+			DealType:   r.DealType,
+			Currency:   r.Currency,
+			Rate:       new(big.Int).SetBytes(r.Rate),
+			Min:        new(big.Int).SetBytes(r.Min),
+			Max:        new(big.Int).SetBytes(r.Max),
+			ValidFrom:  r.ValidFrom,
+			ValidUntil: r.ValidUntil,
 		})
 	}
 	return m, nil
@@ -94,8 +104,8 @@ func (bt *BaseToken) QueryDocumentsList() ([]core.Doc, error) {
 
 // TxAddDocs - adds docs to a token
 func (bt *BaseToken) TxAddDocs(sender *types.Sender, rawDocs string) error {
-	if !sender.Equal(bt.Issuer()) {
-		return errors.New("unathorized")
+	if err := checkRoleHolder(sender, bt.GetIssuer, errors.New("unathorized")); err != nil {
+		return err
 	}
 
 	return core.AddDocs(bt.GetStub(), rawDocs)
@@ -103,89 +113,59 @@ func (bt *BaseToken) TxAddDocs(sender *types.Sender, rawDocs string) error {
 
 // TxDeleteDoc - deletes doc from state
 func (bt *BaseToken) TxDeleteDoc(sender *types.Sender, docID string) error {
-	if !sender.Equal(bt.Issuer()) {
-		return errors.New("unathorized")
+	if err := checkRoleHolder(sender, bt.GetIssuer, errors.New("unathorized")); err != nil {
+		return err
 	}
 
 	return core.DeleteDoc(bt.GetStub(), docID)
 }
 
-// TxSetRate sets token rate to an asset for a type of deal
+// TxSetRate sets token rate to an asset for a type of deal, the rate is valid until it's changed
 func (bt *BaseToken) TxSetRate(sender *types.Sender, dealType string, currency string, rate *big.Int) error {
-	if !sender.Equal(bt.Issuer()) {
-		return errors.New("unauthorized")
+	if err := checkRoleHolder(sender, bt.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
 	}
+	return bt.setRate(dealType, currency, rate, 0, 0)
+}
 
-	if rate.Sign() == 0 {
-		return errors.New("trying to set rate = 0")
-	}
-	if bt.Symbol == currency {
-		return errors.New("currency is equals token: it is impossible")
-	}
+func (bt *BaseToken) setRate(dealType string, currency string, rate *big.Int, validFrom int64, validUntil int64) error {
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return err
 	}
-	for i, r := range bt.config.Rates {
-		if r.DealType == dealType && r.Currency == currency {
-			bt.config.Rates[i].Rate = rate.Bytes()
-			return bt.saveConfig()
-		}
-	}
-	bt.config.Rates = append(bt.config.Rates, &proto.TokenRate{
-		DealType: dealType,
-		Currency: currency,
-		Rate:     rate.Bytes(),
-		Max:      new(big.Int).SetUint64(0).Bytes(),
-		Min:      new(big.Int).SetUint64(0).Bytes(),
-	})
+	rates, err := setTokenRate(bt.GetStub(), bt.config.Rates, bt.Symbol, dealType, currency, rate, validFrom, validUntil)
+	if err != nil {
+		return err
+	}
+	bt.config.Rates = rates
 	return bt.saveConfig()
 }
 
 // TxSetLimits sets limits for a deal type and an asset
 func (bt *BaseToken) TxSetLimits(sender *types.Sender, dealType string, currency string, min *big.Int, max *big.Int) error {
-	if !sender.Equal(bt.Issuer()) {
-		return errors.New("unauthorized")
-	}
-	if min.Cmp(max) > 0 && max.Cmp(big.NewInt(0)) > 0 {
-		return errors.New("min limit is greater than max limit")
+	if err := checkRoleHolder(sender, bt.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
 	}
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return err
 	}
-	unknownDealType := true
-	for i, r := range bt.config.Rates {
-		if r.DealType == dealType {
-			unknownDealType = false
-			if r.Currency == currency {
-				bt.config.Rates[i].Max = max.Bytes()
-				bt.config.Rates[i].Min = min.Bytes()
-				return bt.saveConfig()
-			}
-		}
-	}
-	if unknownDealType {
-		return fmt.Errorf("unknown DealType. Rate for deal type %s and currency %s was not set", dealType, currency)
-	}
-	return fmt.Errorf("unknown currency. Rate for deal type %s and currency %s was not set", dealType, currency)
+	if err := setRateLimits(bt.GetStub(), bt.config.Rates, dealType, currency, min, max); err != nil {
+		return err
+	}
+	return bt.saveConfig()
 }
 
 // TxDeleteRate - deletes rate from state
 func (bt *BaseToken) TxDeleteRate(sender *types.Sender, dealType string, currency string) error {
-	if !sender.Equal(bt.Issuer()) {
-		return errors.New("unauthorized")
-	}
-	if bt.Symbol == currency {
-		return errors.New("currency is equals token: it is impossible")
+	if err := checkRoleHolder(sender, bt.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
 	}
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return err
 	}
-	for i, r := range bt.config.Rates {
-		if r.DealType == dealType && r.Currency == currency {
-			bt.config.Rates = append(bt.config.Rates[:i], bt.config.Rates[i+1:]...)
-			return bt.saveConfig()
-		}
+	rates, deleted, err := deleteTokenRate(bt.GetStub(), bt.config.Rates, bt.Symbol, dealType, currency)
+	if err != nil || !deleted {
+		return err
 	}
-
-	return nil
+	bt.config.Rates = rates
+	return bt.saveConfig()
 }
@@ -0,0 +1,47 @@
+package token
+
+import (
+	"errors"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/proto"
+	pb "github.com/golang/protobuf/proto" //nolint:staticcheck
+)
+
+// versions of migrations of the token, contracts which embed BaseToken
+// and declare their own migrations continue versions after these
+const (
+	tokenMigrationConfig = 1
+)
+
+// ErrTokenConfigNotStored is returned if the config of the token is missing after it's stored by the migration
+var ErrTokenConfigNotStored = errors.New("token config isn't stored")
+
+// Migrations returns migrations of the state of the token in the contract track.
+// Contracts which declare their own migrations should append them to these
+func (bt *BaseToken) Migrations() []core.Migration {
+	return []core.Migration{
+		{Version: tokenMigrationConfig, Name: "store the token config", Step: migrateTokenConfig},
+	}
+}
+
+// migrateTokenConfig stores the config of the token which hasn't been changed yet,
+// the config isn't created on read after the migration
+func migrateTokenConfig(bc *core.BaseContract, _ string, _ int) (string, uint64, error) {
+	data, err := bc.GetStub().GetState(metadataKey)
+	if err != nil {
+		return "", 0, err
+	}
+	config := &proto.Token{}
+	if err = pb.Unmarshal(data, config); err != nil {
+		return "", 0, err
+	}
+	config.Version = tokenMigrationConfig
+	if data, err = pb.Marshal(config); err != nil {
+		return "", 0, err
+	}
+	if err = bc.GetStub().PutState(metadataKey, data); err != nil {
+		return "", 0, err
+	}
+	return "", 1, nil
+}
@@ -0,0 +1,258 @@
+package token
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/atomyze-foundation/foundation/core/types"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/proto"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+)
+
+// actions of the rate history
+const (
+	RateActionSet    = "set"
+	RateActionLimits = "limits"
+	RateActionDelete = "delete"
+)
+
+const (
+	rateHistoryKey         = "rateHistory"
+	rateHistorySeqKey      = "rateHistorySeq"
+	maxRateHistoryPageSize = 1000
+)
+
+// rate errors
+var (
+	ErrRateValidity        = errors.New("valid until must be after valid from")
+	ErrRateNotValid        = errors.New("rate is not valid at the time of the transaction")
+	ErrRateHistoryPageSize = errors.New("page size must be from 1 to 1000")
+	ErrRateHistoryBookmark = errors.New("invalid bookmark")
+)
+
+// RateHistoryRecord is the rate after its change, the rate which applied to the trade
+// is the last record with the timestamp not after the trade
+type RateHistoryRecord struct {
+	Action     string   `json:"action"`
+	DealType   string   `json:"deal_type"` //nolint:tagliatelle
+	Currency   string   `json:"currency"`
+	Rate       *big.Int `json:"rate"`
+	Min        *big.Int `json:"min"`
+	Max        *big.Int `json:"max"`
+	ValidFrom  int64    `json:"valid_from,omitempty"`  //nolint:tagliatelle
+	ValidUntil int64    `json:"valid_until,omitempty"` //nolint:tagliatelle
+	Timestamp  int64    `json:"timestamp"`
+	TxID       string   `json:"tx_id"` //nolint:tagliatelle
+}
+
+// RateHistory is a page of the rate history
+type RateHistory struct {
+	Records  []*RateHistoryRecord `json:"records"`
+	Bookmark string               `json:"bookmark"`
+}
+
+// TxSetRateWithValidity sets token rate to an asset for a type of deal which is valid from validFrom
+// until validUntil in unix seconds, zero means no bound
+func (bt *BaseToken) TxSetRateWithValidity(sender *types.Sender, dealType string, currency string, rate *big.Int, validFrom int64, validUntil int64) error {
+	if err := checkRoleHolder(sender, bt.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
+	}
+	if validFrom < 0 || validUntil < 0 || (validUntil != 0 && validUntil <= validFrom) {
+		return ErrRateValidity
+	}
+	return bt.setRate(dealType, currency, rate, validFrom, validUntil)
+}
+
+// findRate returns the rate for the deal type and currency
+func findRate(rates []*proto.TokenRate, dealType string, currency string) (*proto.TokenRate, bool) {
+	for _, r := range rates {
+		if r.DealType == dealType && r.Currency == currency {
+			return r, true
+		}
+	}
+	return &proto.TokenRate{}, false
+}
+
+// setTokenRate sets the rate for the deal type and currency and appends it to the history,
+// the new rate is added with zero limits
+func setTokenRate(
+	stub shim.ChaincodeStubInterface,
+	rates []*proto.TokenRate,
+	symbol string,
+	dealType string,
+	currency string,
+	rate *big.Int,
+	validFrom int64,
+	validUntil int64,
+) ([]*proto.TokenRate, error) {
+	if rate.Sign() == 0 {
+		return nil, errors.New("trying to set rate = 0")
+	}
+	if symbol == currency {
+		return nil, errors.New("currency is equals token: it is impossible")
+	}
+	tokenRate, exists := findRate(rates, dealType, currency)
+	if !exists {
+		tokenRate = &proto.TokenRate{
+			DealType: dealType,
+			Currency: currency,
+			Max:      new(big.Int).SetUint64(0).Bytes(),
+			Min:      new(big.Int).SetUint64(0).Bytes(),
+		}
+		rates = append(rates, tokenRate)
+	}
+	tokenRate.Rate = rate.Bytes()
+	tokenRate.ValidFrom = validFrom
+	tokenRate.ValidUntil = validUntil
+	if err := addRateHistory(stub, RateActionSet, tokenRate); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// setRateLimits sets limits of the existing rate for the deal type and currency and appends it to the history
+func setRateLimits(stub shim.ChaincodeStubInterface, rates []*proto.TokenRate, dealType string, currency string, min *big.Int, max *big.Int) error {
+	if min.Cmp(max) > 0 && max.Cmp(big.NewInt(0)) > 0 {
+		return errors.New("min limit is greater than max limit")
+	}
+	unknownDealType := true
+	for _, r := range rates {
+		if r.DealType == dealType {
+			unknownDealType = false
+			if r.Currency == currency {
+				r.Max = max.Bytes()
+				r.Min = min.Bytes()
+				return addRateHistory(stub, RateActionLimits, r)
+			}
+		}
+	}
+	if unknownDealType {
+		return fmt.Errorf("unknown DealType. Rate for deal type %s and currency %s was not set", dealType, currency)
+	}
+	return fmt.Errorf("unknown currency. Rate for deal type %s and currency %s was not set", dealType, currency)
+}
+
+// deleteTokenRate removes the rate for the deal type and currency, false is returned if there is no such rate
+func deleteTokenRate(stub shim.ChaincodeStubInterface, rates []*proto.TokenRate, symbol string, dealType string, currency string) ([]*proto.TokenRate, bool, error) {
+	if symbol == currency {
+		return nil, false, errors.New("currency is equals token: it is impossible")
+	}
+	for i, r := range rates {
+		if r.DealType == dealType && r.Currency == currency {
+			if err := addRateHistory(stub, RateActionDelete, r); err != nil {
+				return nil, false, err
+			}
+			return append(rates[:i], rates[i+1:]...), true, nil
+		}
+	}
+	return rates, false, nil
+}
+
+// priceOf checks that the rate exists, is valid at the time of the transaction and the amount is in its limits,
+// and returns the price of the amount
+func priceOf(stub shim.ChaincodeStubInterface, rate *proto.TokenRate, exists bool, amount *big.Int) (*big.Int, error) {
+	if !exists {
+		return big.NewInt(0), errors.New("impossible to buy for this currency")
+	}
+	ts, err := stub.GetTxTimestamp()
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	if !rate.IsValid(ts.Seconds) {
+		return big.NewInt(0), ErrRateNotValid
+	}
+	if !rate.InLimit(amount) {
+		return big.NewInt(0), errors.New("amount out of limits")
+	}
+	return rate.CalcPrice(amount, RateDecimal), nil
+}
+
+// addRateHistory appends the rate to its history
+func addRateHistory(stub shim.ChaincodeStubInterface, action string, rate *proto.TokenRate) error {
+	ts, err := stub.GetTxTimestamp()
+	if err != nil {
+		return err
+	}
+	// records are keyed by the zero-padded sequence number to keep the order of changes
+	// made within the same second
+	seqKey, err := stub.CreateCompositeKey(rateHistorySeqKey, []string{rate.DealType, rate.Currency})
+	if err != nil {
+		return err
+	}
+	rawSeq, err := stub.GetState(seqKey)
+	if err != nil {
+		return err
+	}
+	seq := new(big.Int).Add(new(big.Int).SetBytes(rawSeq), big.NewInt(1))
+	if err = stub.PutState(seqKey, seq.Bytes()); err != nil {
+		return err
+	}
+	key, err := stub.CreateCompositeKey(rateHistoryKey, []string{rate.DealType, rate.Currency, fmt.Sprintf("%020d", seq.Uint64())})
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(&RateHistoryRecord{
+		Action:     action,
+		DealType:   rate.DealType,
+		Currency:   rate.Currency,
+		Rate:       new(big.Int).SetBytes(rate.Rate),
+		Min:        new(big.Int).SetBytes(rate.Min),
+		Max:        new(big.Int).SetBytes(rate.Max),
+		ValidFrom:  rate.ValidFrom,
+		ValidUntil: rate.ValidUntil,
+		Timestamp:  ts.Seconds,
+		TxID:       stub.GetTxID(),
+	})
+	if err != nil {
+		return err
+	}
+	return stub.PutState(key, data)
+}
+
+// QueryRateHistory returns changes of the rate for the deal type and currency from the oldest one
+func (bt *BaseToken) QueryRateHistory(dealType string, currency string, pageSize int64, bookmark string) (*RateHistory, error) {
+	return rateHistory(bt.GetStub(), dealType, currency, pageSize, bookmark)
+}
+
+func rateHistory(stub shim.ChaincodeStubInterface, dealType string, currency string, pageSize int64, bookmark string) (*RateHistory, error) {
+	if pageSize < 1 || pageSize > maxRateHistoryPageSize {
+		return nil, ErrRateHistoryPageSize
+	}
+	if bookmark != "" {
+		prefix, err := stub.CreateCompositeKey(rateHistoryKey, []string{dealType, currency})
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(bookmark, prefix) {
+			return nil, ErrRateHistoryBookmark
+		}
+	}
+
+	iter, meta, err := stub.GetStateByPartialCompositeKeyWithPagination(rateHistoryKey, []string{dealType, currency}, int32(pageSize), bookmark)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	result := &RateHistory{Records: []*RateHistoryRecord{}}
+	for iter.HasNext() {
+		kv, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		record := &RateHistoryRecord{}
+		if err = json.Unmarshal(kv.Value, record); err != nil {
+			return nil, err
+		}
+		result.Records = append(result.Records, record)
+	}
+	if meta != nil {
+		result.Bookmark = meta.Bookmark
+	}
+	return result, nil
+}
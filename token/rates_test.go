@@ -0,0 +1,74 @@
+package token
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/atomyze-foundation/foundation/core"
+	ma "github.com/atomyze-foundation/foundation/mock"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateValidityAndHistory(t *testing.T) {
+	mock := ma.NewLedger(t)
+	issuer := mock.NewWallet()
+	user := mock.NewWallet()
+
+	vt := &VT{
+		BaseToken{
+			Name:     vtName,
+			Symbol:   "VT",
+			Decimals: 8,
+		},
+	}
+
+	mock.NewChainCode("vt", vt, &core.ContractOptions{}, nil, issuer.Address())
+
+	now := mock.GetStub("vt").TxTimestamp.Seconds
+	mock.GetStub("vt").SetTxTimestamp(&timestamp.Timestamp{Seconds: now})
+	defer mock.GetStub("vt").SetTxTimestamp(nil)
+
+	issuer.SignedInvoke("vt", "emitToken", "10")
+	user.AddAllowedBalance("vt", "usd", 10)
+
+	err := issuer.RawSignedInvokeWithErrorReturned("vt", "setRateWithValidity", "buyToken", "usd", "100000000",
+		strconv.FormatInt(now+100, 10), strconv.FormatInt(now, 10))
+	assert.EqualError(t, err, ErrRateValidity.Error())
+
+	issuer.SignedInvoke("vt", "setRateWithValidity", "buyToken", "usd", "100000000",
+		strconv.FormatInt(now+100, 10), strconv.FormatInt(now+200, 10))
+	err = user.RawSignedInvokeWithErrorReturned("vt", "buyToken", "1", "usd")
+	assert.EqualError(t, err, ErrRateNotValid.Error())
+
+	mock.GetStub("vt").SetTxTimestamp(&timestamp.Timestamp{Seconds: now + 150})
+	user.SignedInvoke("vt", "buyToken", "1", "usd")
+	user.BalanceShouldBe("vt", 1)
+
+	mock.GetStub("vt").SetTxTimestamp(&timestamp.Timestamp{Seconds: now + 200})
+	err = user.RawSignedInvokeWithErrorReturned("vt", "buyToken", "1", "usd")
+	assert.EqualError(t, err, ErrRateNotValid.Error())
+
+	issuer.SignedInvoke("vt", "setRate", "buyToken", "usd", "200000000")
+	issuer.SignedInvoke("vt", "setLimits", "buyToken", "usd", "1", "10")
+	user.SignedInvoke("vt", "buyToken", "2", "usd")
+	user.AllowedBalanceShouldBe("vt", "usd", 5)
+
+	history := &RateHistory{}
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke("vt", "rateHistory", "buyToken", "usd", "2", "")), history))
+	assert.Len(t, history.Records, 2)
+	assert.Equal(t, now+100, history.Records[0].ValidFrom)
+	assert.Equal(t, "100000000", history.Records[0].Rate.String())
+	assert.Equal(t, now+200, history.Records[1].Timestamp)
+	assert.Equal(t, int64(0), history.Records[1].ValidUntil)
+	assert.NotEmpty(t, history.Bookmark)
+
+	next := &RateHistory{}
+	assert.NoError(t, json.Unmarshal([]byte(user.Invoke("vt", "rateHistory", "buyToken", "usd", "2", history.Bookmark)), next))
+	assert.Len(t, next.Records, 1)
+	assert.Equal(t, RateActionLimits, next.Records[0].Action)
+
+	err = user.InvokeWithError("vt", "rateHistory", "buyToken", "usd", "0", "")
+	assert.EqualError(t, err, ErrRateHistoryPageSize.Error())
+}
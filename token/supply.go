@@ -0,0 +1,139 @@
+package token
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/types/big"
+)
+
+const (
+	maxHoldersPageSize = 1000
+
+	// totalEmissionSnapshotKey is the object type of the total emission in snapshots
+	totalEmissionSnapshotKey = "totalEmission"
+)
+
+// supply errors
+var (
+	ErrHoldersPageSize        = errors.New("page size must be from 1 to 1000")
+	ErrReconciliationBookmark = errors.New("invalid reconciliation bookmark")
+)
+
+// Holders is a page of token holders
+type Holders struct {
+	Holders  []*core.TokenHolder `json:"holders"`
+	Bookmark string              `json:"bookmark"`
+}
+
+// SupplyReconciliation is the result of summing balances of all holders.
+// Balance, Locked and Holders are accumulated over all pages processed so far,
+// Drift is TotalEmission minus the sum of balances, locked and given balances,
+// it's final when Done is true
+type SupplyReconciliation struct {
+	TotalEmission *big.Int `json:"total_emission"` //nolint:tagliatelle
+	Balance       *big.Int `json:"balance"`
+	Locked        *big.Int `json:"locked"`
+	Given         *big.Int `json:"given"`
+	Holders       uint64   `json:"holders"`
+	Drift         *big.Int `json:"drift"`
+	Bookmark      string   `json:"bookmark"`
+	Done          bool     `json:"done"`
+}
+
+// reconciliationCursor is the decoded bookmark of the reconciliation with the sums of previous pages
+type reconciliationCursor struct {
+	Holders string   `json:"holders"`
+	Balance *big.Int `json:"balance"`
+	Locked  *big.Int `json:"locked"`
+	Count   uint64   `json:"count"`
+}
+
+// QueryHolders returns a page of addresses with their token and locked token balances.
+// Pass the returned bookmark to get the next page, it's empty after the last page
+func (bt *BaseToken) QueryHolders(pageSize int64, bookmark string) (*Holders, error) {
+	if pageSize < 1 || pageSize > maxHoldersPageSize {
+		return nil, ErrHoldersPageSize
+	}
+	holders, next, err := bt.TokenHolders(int32(pageSize), bookmark)
+	if err != nil {
+		return nil, err
+	}
+	return &Holders{Holders: holders, Bookmark: next}, nil
+}
+
+// QueryReconcileSupply sums balances of holders page by page and compares the sum with TotalEmission.
+// Start with an empty bookmark and pass the returned bookmark until Done is true.
+// Given balances (tokens swapped or transferred to other channels) are added on the last page.
+// Swaps and cross-channel transfers in progress are reported as drift
+func (bt *BaseToken) QueryReconcileSupply(pageSize int64, bookmark string) (*SupplyReconciliation, error) {
+	if pageSize < 1 || pageSize > maxHoldersPageSize {
+		return nil, ErrHoldersPageSize
+	}
+
+	cursor := &reconciliationCursor{Balance: new(big.Int), Locked: new(big.Int)}
+	if bookmark != "" {
+		data, err := base64.StdEncoding.DecodeString(bookmark)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrReconciliationBookmark, err.Error())
+		}
+		if err = json.Unmarshal(data, cursor); err != nil || cursor.Balance == nil || cursor.Locked == nil {
+			return nil, ErrReconciliationBookmark
+		}
+	}
+
+	holders, next, err := bt.TokenHolders(int32(pageSize), cursor.Holders)
+	if err != nil {
+		return nil, err
+	}
+	for _, holder := range holders {
+		cursor.Balance.Add(cursor.Balance, holder.Balance)
+		cursor.Locked.Add(cursor.Locked, holder.Locked)
+		cursor.Count++
+	}
+
+	if err = bt.loadConfigUnlessLoaded(); err != nil {
+		return nil, err
+	}
+	result := &SupplyReconciliation{
+		TotalEmission: new(big.Int).SetBytes(bt.config.TotalEmission),
+		Balance:       cursor.Balance,
+		Locked:        cursor.Locked,
+		Given:         new(big.Int),
+		Holders:       cursor.Count,
+		Done:          next == "",
+	}
+
+	if result.Done {
+		given, err := core.GivenBalances(bt.GetStub())
+		if err != nil {
+			return nil, err
+		}
+		for _, balance := range given {
+			result.Given.Add(result.Given, balance)
+		}
+	} else {
+		cursor.Holders = next
+		data, err := json.Marshal(cursor)
+		if err != nil {
+			return nil, err
+		}
+		result.Bookmark = base64.StdEncoding.EncodeToString(data)
+	}
+
+	result.Drift = new(big.Int).Sub(result.TotalEmission, cursor.Balance)
+	result.Drift.Sub(result.Drift, cursor.Locked)
+	result.Drift.Sub(result.Drift, result.Given)
+	return result, nil
+}
+
+// QueryTotalSupplyAtSnapshot returns TotalEmission at the moment the snapshot was opened
+func (bt *BaseToken) QueryTotalSupplyAtSnapshot(snapshotID uint64) (*big.Int, error) {
+	if err := bt.loadConfigUnlessLoaded(); err != nil {
+		return nil, err
+	}
+	return bt.ValueAtSnapshot(totalEmissionSnapshotKey, nil, snapshotID, new(big.Int).SetBytes(bt.config.TotalEmission))
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/atomyze-foundation/foundation/core"
+	"github.com/atomyze-foundation/foundation/core/initialize"
 	"github.com/atomyze-foundation/foundation/core/types"
 	"github.com/atomyze-foundation/foundation/core/types/big"
 	"github.com/atomyze-foundation/foundation/proto"
@@ -11,9 +12,9 @@ import (
 )
 
 const (
-	// FeeSetterArgPos is the position of the fee setter in the init args
+	// FeeSetterArgPos is the position of the fee setter in the init args, the role registry starts with it
 	FeeSetterArgPos = 1
-	// FeeAddressSetterArgPos is the position of the fee address setter in the init args
+	// FeeAddressSetterArgPos is the position of the fee address setter in the init args, the role registry starts with it
 	FeeAddressSetterArgPos = 2
 	metadataKey            = "tokenMetadata"
 )
@@ -37,31 +38,76 @@ type BaseToken struct {
 	config *proto.Token
 }
 
-// Issuer returns the issuer of the token
-func (bt *BaseToken) Issuer() *types.Address {
-	addr, err := types.AddrFromBase58Check(bt.GetInitArg(0))
-	if err != nil {
-		panic(err)
+// InitSchema returns init args of the token: the issuer, the fee setter and the fee address setter
+func (bt *BaseToken) InitSchema() *initialize.Schema {
+	return tokenInitSchema()
+}
+
+func tokenInitSchema() *initialize.Schema {
+	return &initialize.Schema{
+		Args: []initialize.Field{
+			{Name: "issuer", Type: initialize.FieldAddress, Required: true},
+			{Name: "feeSetter", Type: initialize.FieldAddress},
+			{Name: "feeAddressSetter", Type: initialize.FieldAddress},
+		},
 	}
-	return addr
 }
 
-// FeeSetter returns the fee setter of the token
+// Issuer returns the issuer of the token from the role registry, it panics if the issuer can't be read,
+// methods should use GetIssuer
+func (bt *BaseToken) Issuer() *types.Address {
+	return mustRoleHolder(bt.GetIssuer)
+}
+
+// FeeSetter returns the fee setter of the token from the role registry, it panics if the fee setter
+// can't be read or isn't set, methods should use GetFeeSetter
 func (bt *BaseToken) FeeSetter() *types.Address {
-	addr, err := types.AddrFromBase58Check(bt.GetInitArg(FeeSetterArgPos))
+	return mustRoleHolder(bt.GetFeeSetter)
+}
+
+// FeeAddressSetter returns the fee address setter of the token from the role registry, it panics
+// if the fee address setter can't be read or isn't set, methods should use GetFeeAddressSetter
+func (bt *BaseToken) FeeAddressSetter() *types.Address {
+	return mustRoleHolder(bt.GetFeeAddressSetter)
+}
+
+// GetIssuer returns the issuer of the token from the role registry
+func (bt *BaseToken) GetIssuer() (*types.Address, error) {
+	return bt.GetRoleHolder(core.RoleAdmin)
+}
+
+// GetFeeSetter returns the fee setter of the token from the role registry
+func (bt *BaseToken) GetFeeSetter() (*types.Address, error) {
+	return bt.GetRoleHolder(core.RoleFeeSetter)
+}
+
+// GetFeeAddressSetter returns the fee address setter of the token from the role registry
+func (bt *BaseToken) GetFeeAddressSetter() (*types.Address, error) {
+	return bt.GetRoleHolder(core.RoleFeeAddressSetter)
+}
+
+func mustRoleHolder(getHolder func() (*types.Address, error)) *types.Address {
+	addr, err := getHolder()
 	if err != nil {
 		panic(err)
 	}
 	return addr
 }
 
-// FeeAddressSetter returns the fee address setter of the token
-func (bt *BaseToken) FeeAddressSetter() *types.Address {
-	addr, err := types.AddrFromBase58Check(bt.GetInitArg(FeeAddressSetterArgPos))
+// checkRoleHolder returns errUnauthorized if the sender isn't the holder of the role
+// or the role isn't set, and the error of reading the role holder
+func checkRoleHolder(sender *types.Sender, getHolder func() (*types.Address, error), errUnauthorized error) error {
+	holder, err := getHolder()
+	if errors.Is(err, core.ErrRoleNotSet) {
+		return errUnauthorized
+	}
 	if err != nil {
-		panic(err)
+		return err
 	}
-	return addr
+	if !sender.Equal(holder) {
+		return errUnauthorized
+	}
+	return nil
 }
 
 // GetID returns the ID of the token
@@ -79,12 +125,21 @@ func (bt *BaseToken) loadConfigUnlessLoaded() error {
 	}
 
 	if len(data) == 0 {
+		// the empty config is used until the config is stored by the migration
+		version, err := bt.SchemaVersion(core.MigrationTrackContract)
+		if err != nil {
+			return err
+		}
+		if version >= tokenMigrationConfig {
+			return ErrTokenConfigNotStored
+		}
 		return nil
 	}
 	return pb.Unmarshal(data, bt.config)
 }
 
 func (bt *BaseToken) saveConfig() error {
+	bt.config.Version = tokenMigrationConfig
 	data, err := pb.Marshal(bt.config)
 	if err != nil {
 		return err
@@ -100,6 +155,9 @@ func (bt *BaseToken) EmissionAdd(amount *big.Int) error {
 	if bt.config.TotalEmission == nil {
 		bt.config.TotalEmission = new(big.Int).Bytes()
 	}
+	if err := bt.SnapshotSave(totalEmissionSnapshotKey, nil, new(big.Int).SetBytes(bt.config.TotalEmission)); err != nil {
+		return err
+	}
 	bt.config.TotalEmission = new(big.Int).Add(new(big.Int).SetBytes(bt.config.TotalEmission), amount).Bytes()
 	return bt.saveConfig()
 }
@@ -115,6 +173,9 @@ func (bt *BaseToken) EmissionSub(amount *big.Int) error {
 	if new(big.Int).SetBytes(bt.config.TotalEmission).Cmp(amount) < 0 {
 		return errors.New("emission can't become negative")
 	}
+	if err := bt.SnapshotSave(totalEmissionSnapshotKey, nil, new(big.Int).SetBytes(bt.config.TotalEmission)); err != nil {
+		return err
+	}
 	bt.config.TotalEmission = new(big.Int).Sub(new(big.Int).SetBytes(bt.config.TotalEmission), amount).Bytes()
 	return bt.saveConfig()
 }
@@ -123,26 +184,20 @@ func (bt *BaseToken) setFee(currency string, fee *big.Int, floor *big.Int, cap *
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return err
 	}
-	if bt.config.Fee == nil {
-		bt.config.Fee = &proto.TokenFee{}
-	}
-	if currency == bt.Symbol {
-		bt.config.Fee.Currency = currency
-		bt.config.Fee.Fee = fee.Bytes()
-		bt.config.Fee.Floor = floor.Bytes()
-		bt.config.Fee.Cap = cap.Bytes()
-		return bt.saveConfig()
-	}
-	for _, rate := range bt.config.Rates {
-		if rate.Currency == currency {
-			bt.config.Fee.Currency = currency
-			bt.config.Fee.Fee = fee.Bytes()
-			bt.config.Fee.Floor = floor.Bytes()
-			bt.config.Fee.Cap = cap.Bytes()
-			return bt.saveConfig()
+	tokenFee, err := newTokenFee(bt.Symbol, bt.config.Rates, currency, fee, floor, cap)
+	if err != nil {
+		return err
+	}
+	bt.config.Fee = tokenFee
+	// the fee replaces the transfer schedule, otherwise the schedule would override it
+	schedules := make([]*proto.FeeSchedule, 0, len(bt.config.FeeSchedules))
+	for _, schedule := range bt.config.FeeSchedules {
+		if schedule.Operation != FeeOperationTransfer {
+			schedules = append(schedules, schedule)
 		}
 	}
-	return errors.New("unknown currency")
+	bt.config.FeeSchedules = schedules
+	return bt.saveConfig()
 }
 
 // GetRateAndLimits returns rate and limits for the deal type and currency
@@ -150,10 +205,6 @@ func (bt *BaseToken) GetRateAndLimits(dealType string, currency string) (*proto.
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return nil, false, err
 	}
-	for _, r := range bt.config.Rates {
-		if r.DealType == dealType && r.Currency == currency {
-			return r, true, nil
-		}
-	}
-	return &proto.TokenRate{}, false, nil
+	rate, exists := findRate(bt.config.Rates, dealType, currency)
+	return rate, exists, nil
 }
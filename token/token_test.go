@@ -60,23 +60,32 @@ type TestToken struct {
 }
 
 func (tt *TestToken) QueryGetIssuer() (string, error) {
-	addr := tt.Issuer().String()
-	return addr, nil
+	addr, err := tt.GetIssuer()
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
 }
 
 func (tt *TestToken) QueryGetFeeSetter() (string, error) {
-	addr := tt.FeeSetter().String()
-	return addr, nil
+	addr, err := tt.GetFeeSetter()
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
 }
 
 func (tt *TestToken) QueryGetFeeAddressSetter() (string, error) {
-	addr := tt.FeeAddressSetter().String()
-	return addr, nil
+	addr, err := tt.GetFeeAddressSetter()
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
 }
 
 func (tt *TestToken) TxEmissionAdd(sender *types.Sender, address *types.Address, amount *big.Int) error {
-	if !sender.Equal(tt.Issuer()) {
-		return errors.New("unauthorized")
+	if err := checkRoleHolder(sender, tt.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
 	}
 
 	if amount.Cmp(big.NewInt(0)) == 0 {
@@ -89,8 +98,8 @@ func (tt *TestToken) TxEmissionAdd(sender *types.Sender, address *types.Address,
 }
 
 func (tt *TestToken) TxEmissionSub(sender *types.Sender, address *types.Address, amount *big.Int) error {
-	if !sender.Equal(tt.Issuer()) {
-		return errors.New("unauthorized")
+	if err := checkRoleHolder(sender, tt.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
 	}
 
 	if amount.Cmp(big.NewInt(0)) == 0 {
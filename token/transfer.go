@@ -7,6 +7,7 @@ import (
 	"github.com/atomyze-foundation/foundation/core/helpers"
 	"github.com/atomyze-foundation/foundation/core/types"
 	"github.com/atomyze-foundation/foundation/core/types/big"
+	"github.com/atomyze-foundation/foundation/proto"
 )
 
 const (
@@ -25,20 +26,24 @@ func (bt *BaseToken) TxTransfer(sender *types.Sender, to *types.Address, amount
 		return errors.New("amount should be more than zero")
 	}
 
+	return bt.transfer(sender.Address(), to, amount, "transfer")
+}
+
+// transfer transfers tokens and charges the fee from the from address
+func (bt *BaseToken) transfer(from *types.Address, to *types.Address, amount *big.Int, reason string) error {
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return err
 	}
 
-	if bt.config.Fee != nil && len(bt.config.FeeAddress) == 0 {
-		return errors.New("fee address is not set")
+	if bt.config.Fee != nil && len(bt.config.FeeAddress) == 0 && len(bt.config.FeeRecipients) == 0 {
+		return ErrFeeAddressUnset
 	}
 
-	if err := bt.TokenBalanceTransfer(sender.Address(), to, amount, "transfer"); err != nil {
+	if err := bt.ChargeVelocity(from, bt.Symbol, amount); err != nil {
 		return err
 	}
 
-	fee, err := bt.calcFee(amount)
-	if err != nil {
+	if err := bt.TokenBalanceTransfer(from, to, amount, reason); err != nil {
 		return err
 	}
 
@@ -49,17 +54,10 @@ func (bt *BaseToken) TxTransfer(sender *types.Sender, to *types.Address, amount
 	}
 	to = (*types.Address)(fullAdr)
 
-	if !sender.Address().IsUserIDSame(to) && fee.Fee.Cmp(new(big.Int).SetInt64(0)) != 0 {
-		if types.IsValidAddressLen(bt.config.FeeAddress) && bt.config.Fee != nil && bt.config.Fee.Currency != "" {
-			feeAddr := types.AddrFromBytes(bt.config.FeeAddress)
-			if bt.config.Fee.Currency == bt.Symbol {
-				return bt.TokenBalanceTransfer(sender.Address(), feeAddr, fee.Fee, "transfer fee")
-			}
-			return bt.AllowedBalanceTransfer(fee.Currency, sender.Address(), feeAddr, fee.Fee, "transfer fee")
-		}
+	if from.IsUserIDSame(to) {
+		return nil
 	}
-
-	return nil
+	return bt.chargeFee(FeeOperationTransfer, from, to, amount, reason)
 }
 
 // TxAllowedIndustrialBalanceTransfer transfers tokens from one account to another
@@ -82,9 +80,13 @@ func (bt *BaseToken) TxAllowedIndustrialBalanceTransfer(sender *types.Sender, to
 	}
 
 	for _, industrialAsset := range assets {
-		if new(big.Int).SetBytes(industrialAsset.Amount).Cmp(big.NewInt(0)) == 0 {
+		amount := new(big.Int).SetBytes(industrialAsset.Amount)
+		if amount.Cmp(big.NewInt(0)) == 0 {
 			return errors.New("amount should be more than zero")
 		}
+		if err = bt.ChargeVelocity(sender.Address(), industrialAsset.Group, amount); err != nil {
+			return err
+		}
 	}
 
 	return bt.AllowedIndustrialBalanceTransfer(sender.Address(), to, assets, "transfer")
@@ -98,18 +100,19 @@ type Predict struct {
 	Fee *big.Int `json:"fee"`
 }
 
-// QueryPredictFee returns the predicted fee
+// QueryPredictFee returns the predicted fee of the transfer between addresses which aren't exempt from fees,
+// QueryPredictTransferFee takes exemptions of the addresses into account
 func (bt *BaseToken) QueryPredictFee(amount *big.Int) (*Predict, error) {
-	return bt.calcFee(amount)
+	return bt.predictOperationFee(FeeOperationTransfer, nil, nil, amount)
 }
 
-// TxSetFee sets the fee
+// TxSetFee sets the fee of the transfer, it deletes the transfer fee schedule
 func (bt *BaseToken) TxSetFee(sender *types.Sender, currency string, fee *big.Int, floor *big.Int, cap *big.Int) error {
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return err
 	}
-	if !sender.Equal(bt.FeeSetter()) {
-		return errors.New("unauthorized")
+	if err := checkRoleHolder(sender, bt.GetFeeSetter, errors.New("unauthorized")); err != nil {
+		return err
 	}
 	if fee.Cmp(new(big.Int).SetInt64(100000000)) > 0 { //nolint:gomnd
 		return errors.New("fee should be equal or less than 100%")
@@ -122,8 +125,8 @@ func (bt *BaseToken) TxSetFee(sender *types.Sender, currency string, fee *big.In
 
 // TxSetFeeAddress sets the fee address
 func (bt *BaseToken) TxSetFeeAddress(sender *types.Sender, address *types.Address) error {
-	if !sender.Equal(bt.FeeAddressSetter()) {
-		return errors.New("unauthorized")
+	if err := checkRoleHolder(sender, bt.GetFeeAddressSetter, errors.New("unauthorized")); err != nil {
+		return err
 	}
 
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
@@ -137,15 +140,36 @@ func (bt *BaseToken) calcFee(amount *big.Int) (*Predict, error) {
 	if err := bt.loadConfigUnlessLoaded(); err != nil {
 		return &Predict{}, err
 	}
+	return calcTokenFee(bt.config.Fee, bt.Symbol, bt.config.Rates, amount)
+}
 
-	if bt.config.Fee == nil || bt.config.Fee.Fee == nil || new(big.Int).SetBytes(bt.config.Fee.Fee).Cmp(big.NewInt(0)) == 0 {
-		return &Predict{Fee: big.NewInt(0), Currency: bt.Symbol}, nil
+// newTokenFee returns the fee in the token or in the currency which has a rate
+func newTokenFee(symbol string, rates []*proto.TokenRate, currency string, fee *big.Int, floor *big.Int, cap *big.Int) (*proto.TokenFee, error) {
+	known := currency == symbol
+	for _, rate := range rates {
+		known = known || rate.Currency == currency
+	}
+	if !known {
+		return nil, errors.New("unknown currency")
+	}
+	return &proto.TokenFee{
+		Currency: currency,
+		Fee:      fee.Bytes(),
+		Floor:    floor.Bytes(),
+		Cap:      cap.Bytes(),
+	}, nil
+}
+
+// calcTokenFee returns the fee of the amount, the fee in another currency is converted by the buyToken rate
+func calcTokenFee(tokenFee *proto.TokenFee, symbol string, rates []*proto.TokenRate, amount *big.Int) (*Predict, error) {
+	if tokenFee == nil || tokenFee.Fee == nil || new(big.Int).SetBytes(tokenFee.Fee).Cmp(big.NewInt(0)) == 0 {
+		return &Predict{Fee: big.NewInt(0), Currency: symbol}, nil
 	}
 
 	fee := new(big.Int).Div(
 		new(big.Int).Mul(
 			amount,
-			new(big.Int).SetBytes(bt.config.Fee.Fee),
+			new(big.Int).SetBytes(tokenFee.Fee),
 		),
 		new(big.Int).Exp(
 			new(big.Int).SetUint64(10), //nolint:gomnd
@@ -154,11 +178,8 @@ func (bt *BaseToken) calcFee(amount *big.Int) (*Predict, error) {
 		),
 	)
 
-	if bt.config.Fee.Currency != bt.Symbol {
-		rate, ok, err := bt.GetRateAndLimits("buyToken", bt.config.Fee.Currency)
-		if err != nil {
-			return &Predict{}, err
-		}
+	if tokenFee.Currency != symbol {
+		rate, ok := findRate(rates, "buyToken", tokenFee.Currency)
 		if !ok {
 			return &Predict{}, errors.New("incorrect fee currency")
 		}
@@ -176,14 +197,14 @@ func (bt *BaseToken) calcFee(amount *big.Int) (*Predict, error) {
 		)
 	}
 
-	if fee.Cmp(new(big.Int).SetBytes(bt.config.Fee.Floor)) < 0 {
-		fee = new(big.Int).SetBytes(bt.config.Fee.Floor)
+	if fee.Cmp(new(big.Int).SetBytes(tokenFee.Floor)) < 0 {
+		fee = new(big.Int).SetBytes(tokenFee.Floor)
 	}
 
-	cp := new(big.Int).SetBytes(bt.config.Fee.Cap)
+	cp := new(big.Int).SetBytes(tokenFee.Cap)
 	if cp.Cmp(big.NewInt(0)) > 0 && fee.Cmp(cp) > 0 {
-		fee = new(big.Int).SetBytes(bt.config.Fee.Cap)
+		fee = new(big.Int).SetBytes(tokenFee.Cap)
 	}
 
-	return &Predict{Fee: fee, Currency: bt.config.Fee.Currency}, nil
+	return &Predict{Fee: fee, Currency: tokenFee.Currency}, nil
 }
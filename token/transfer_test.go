@@ -8,6 +8,7 @@ import (
 	"github.com/atomyze-foundation/foundation/core"
 	"github.com/atomyze-foundation/foundation/core/types"
 	ma "github.com/atomyze-foundation/foundation/mock"
+	"github.com/atomyze-foundation/foundation/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -130,6 +131,17 @@ func TestAllowedIndustrialBalanceTransfer(t *testing.T) {
 	rawGA, err := json.Marshal(industrialAssets)
 	assert.NoError(t, err)
 
+	// the transfer of allowed balances isn't charged, the issuer doesn't have tokens to pay the fee
+	feeAddressSetter.SignedInvoke("vt", "setFeeAddress", feeSetter.Address())
+	feeSetter.SignedInvoke("vt", "setFee", "VT", "500000", "1", "0")
+	data, err := json.Marshal(&proto.FeeSchedule{Operation: FeeOperationTransfer, Currency: "VT", Tiers: []*proto.FeeTier{{From: "0", Flat: "1"}}})
+	assert.NoError(t, err)
+	feeSetter.SignedInvoke("vt", "setFeeSchedule", string(data))
+	data, err = json.Marshal(&proto.FeeSchedule{Operation: "allowedIndustrialTransfer", Currency: "VT", Tiers: []*proto.FeeTier{{From: "0", Flat: "1"}}})
+	assert.NoError(t, err)
+	err = feeSetter.RawSignedInvokeWithErrorReturned("vt", "setFeeSchedule", string(data))
+	assert.ErrorContains(t, err, ErrFeeOperation.Error())
+
 	issuer.SignedInvoke("vt", "allowedIndustrialBalanceTransfer", user.Address(), string(rawGA), "ref")
 	issuer.AllowedBalanceShouldBe("vt", ba1, 50000000)
 	issuer.AllowedBalanceShouldBe("vt", ba2, 0)
@@ -15,10 +15,10 @@ type VT struct {
 
 // TxEmitToken emits tokens
 func (vt *VT) TxEmitToken(sender *types.Sender, amount *big.Int) error {
-	if !sender.Equal(vt.Issuer()) {
-		return errors.New("unauthorized")
+	if err := checkRoleHolder(sender, vt.GetIssuer, errors.New("unauthorized")); err != nil {
+		return err
 	}
-	if err := vt.TokenBalanceAdd(vt.Issuer(), amount, "emitToken"); err != nil {
+	if err := vt.TokenBalanceAdd(sender.Address(), amount, "emitToken"); err != nil {
 		return err
 	}
 	return vt.EmissionAdd(amount)